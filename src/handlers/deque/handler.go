@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"golabs/src/services/deque"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DequeHandler struct {
+	dequeService deque.DequeService
+}
+
+func NewDequeHandler() *DequeHandler {
+	return &DequeHandler{
+		dequeService: deque.NewDequeService(),
+	}
+}
+
+func (handler *DequeHandler) Initialize(c *gin.Context) {
+	var request InitializeRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	mode := deque.Mode(request.Mode)
+	if mode == "" {
+		mode = deque.ModeBounded
+	}
+
+	if err := handler.dequeService.Initialize(request.Capacity, mode); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	capacity, _ := handler.dequeService.Capacity()
+
+	c.JSON(200, gin.H{
+		"status":   "initialized",
+		"capacity": capacity,
+		"mode":     mode,
+	})
+}
+
+func (handler *DequeHandler) PushFront(c *gin.Context) {
+	var request PushRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.dequeService.PushFront(request.Value); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "pushed to front",
+		"value":  request.Value,
+	})
+}
+
+func (handler *DequeHandler) PushBack(c *gin.Context) {
+	var request PushRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.dequeService.PushBack(request.Value); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "pushed to back",
+		"value":  request.Value,
+	})
+}
+
+func (handler *DequeHandler) PopFront(c *gin.Context) {
+	response, err := handler.dequeService.PopFront()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *DequeHandler) PopBack(c *gin.Context) {
+	response, err := handler.dequeService.PopBack()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *DequeHandler) PeekFront(c *gin.Context) {
+	response, err := handler.dequeService.PeekFront()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *DequeHandler) PeekBack(c *gin.Context) {
+	response, err := handler.dequeService.PeekBack()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *DequeHandler) Get(c *gin.Context) {
+	var params GetIndex
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	response, err := handler.dequeService.Get(*params.Index)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"index":  *params.Index,
+		"value":  response,
+	})
+}
+
+func (handler *DequeHandler) List(c *gin.Context) {
+	response, err := handler.dequeService.ToSlice()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *DequeHandler) Size(c *gin.Context) {
+	response, err := handler.dequeService.Size()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	capacity, _ := handler.dequeService.Capacity()
+
+	c.JSON(200, gin.H{
+		"status":   "Ok",
+		"size":     response,
+		"capacity": capacity,
+	})
+}
+
+func (handler *DequeHandler) IsEmpty(c *gin.Context) {
+	response, err := handler.dequeService.IsEmpty()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"empty":  response,
+	})
+}
@@ -0,0 +1,14 @@
+package handlers
+
+type InitializeRequest struct {
+	Capacity int    `json:"capacity" binding:"required"`
+	Mode     string `json:"mode" binding:"omitempty,oneof=bounded growable"`
+}
+
+type PushRequest struct {
+	Value string `json:"value" binding:"required"`
+}
+
+type GetIndex struct {
+	Index *int `form:"index" binding:"required"`
+}
@@ -2,18 +2,29 @@ package hashtable
 
 import (
 	"errors"
+	"fmt"
+	"sync"
+
 	hashtable "golabs/src/services/hashtable"
+	"golabs/src/services/history"
 
 	"github.com/gin-gonic/gin"
 )
 
+// HashTableHandler holds mu while it mutates the table and records the
+// command, and the recorded commands take it again when history runs them.
+// Seed, Reset and Clear are not recorded, so they forget the table's history.
+// Get shares it with the other readers.
 type HashTableHandler struct {
+	mu               sync.RWMutex
 	hashtableService hashtable.HashTableService
+	historyService   history.HistoryService
 }
 
-func NewHashTableHandler() *HashTableHandler {
+func NewHashTableHandler(historyService history.HistoryService) *HashTableHandler {
 	return &HashTableHandler{
 		hashtableService: hashtable.NewHashTable(),
+		historyService:   historyService,
 	}
 }
 
@@ -25,7 +36,11 @@ func (handler *HashTableHandler) Seed(c *gin.Context) {
 		return
 	}
 
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	size := handler.hashtableService.Seed()
+	handler.historyService.Forget("hashtable")
 
 	c.JSON(200, gin.H{
 		"status": "seeded",
@@ -36,14 +51,24 @@ func (handler *HashTableHandler) Seed(c *gin.Context) {
 }
 
 func (handler *HashTableHandler) Reset(c *gin.Context) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	handler.hashtableService.Reset()
+	handler.historyService.Forget("hashtable")
+
 	c.JSON(200, gin.H{
 		"status": "hash table has been reseted",
 	})
 }
 
 func (handler *HashTableHandler) Clear(c *gin.Context) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	handler.hashtableService.Clear()
+	handler.historyService.Forget("hashtable")
+
 	c.JSON(200, gin.H{
 		"status": "hash table has been cleared",
 	})
@@ -57,13 +82,35 @@ func (handler *HashTableHandler) Upsert(c *gin.Context) {
 		return
 	}
 
-	oldValue, replaced, err := handler.hashtableService.Upsert(request.Key, request.Value, hashtable.HashFnType(request.HashFnType))
+	hashFnType := hashtable.HashFnType(request.HashFnType)
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	oldValue, replaced, err := handler.hashtableService.Upsert(request.Key, request.Value, hashFnType)
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	handler.historyService.Record(history.Command{
+		Service:     "hashtable",
+		Description: fmt.Sprintf("upsert %q = %q", request.Key, request.Value),
+		Undo: handler.locked(func() error {
+			if replaced {
+				_, _, err := handler.hashtableService.Upsert(request.Key, oldValue, hashFnType)
+				return err
+			}
+			_, err := handler.hashtableService.Delete(request.Key)
+			return err
+		}),
+		Redo: handler.locked(func() error {
+			_, _, err := handler.hashtableService.Upsert(request.Key, request.Value, hashFnType)
+			return err
+		}),
+	})
+
 	c.JSON(201, gin.H{
 		"status": "node added",
 		"data": gin.H{
@@ -82,6 +129,9 @@ func (handler *HashTableHandler) Get(c *gin.Context) {
 		return
 	}
 
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	valueFound, err := handler.hashtableService.Get(request.Key)
 
 	if errors.Is(err, hashtable.ErrNotFound) {
@@ -110,6 +160,9 @@ func (handler *HashTableHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	deletedValue, err := handler.hashtableService.Delete(request.Key)
 
 	if errors.Is(err, hashtable.ErrNotFound) {
@@ -122,6 +175,21 @@ func (handler *HashTableHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	// Delete leaves the hash function in place, so Upsert ignores the type
+	// passed on undo.
+	handler.historyService.Record(history.Command{
+		Service:     "hashtable",
+		Description: fmt.Sprintf("delete %q", request.Key),
+		Undo: handler.locked(func() error {
+			_, _, err := handler.hashtableService.Upsert(request.Key, deletedValue, hashtable.HashFnBasic)
+			return err
+		}),
+		Redo: handler.locked(func() error {
+			_, err := handler.hashtableService.Delete(request.Key)
+			return err
+		}),
+	})
+
 	c.JSON(200, gin.H{
 		"status": "pair deleted",
 		"data": gin.H{
@@ -129,3 +197,13 @@ func (handler *HashTableHandler) Delete(c *gin.Context) {
 		},
 	})
 }
+
+// locked wraps a history callback so it runs under the handler's lock.
+func (handler *HashTableHandler) locked(run func() error) func() error {
+	return func() error {
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+
+		return run()
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"golabs/src/services/history"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HistoryHandler struct {
+	historyService history.HistoryService
+}
+
+func NewHistoryHandler(historyService history.HistoryService) *HistoryHandler {
+	return &HistoryHandler{
+		historyService: historyService,
+	}
+}
+
+func (handler *HistoryHandler) Undo(c *gin.Context) {
+	response, err := handler.historyService.Undo()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "command undone",
+		"value":  ToEntryView(response),
+	})
+}
+
+func (handler *HistoryHandler) Redo(c *gin.Context) {
+	response, err := handler.historyService.Redo()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "command redone",
+		"value":  ToEntryView(response),
+	})
+}
+
+func (handler *HistoryHandler) List(c *gin.Context) {
+	response := handler.historyService.List()
+
+	c.JSON(200, gin.H{
+		"status": "history retrieved",
+		"undo":   ToEntryViews(response.Undo),
+		"redo":   ToEntryViews(response.Redo),
+	})
+}
+
+func (handler *HistoryHandler) Clear(c *gin.Context) {
+	handler.historyService.Clear()
+
+	c.JSON(200, gin.H{"status": "history cleared"})
+}
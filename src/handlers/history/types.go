@@ -0,0 +1,25 @@
+package handlers
+
+import "golabs/src/services/history"
+
+type EntryView struct {
+	ID          int    `json:"id"`
+	Service     string `json:"service"`
+	Description string `json:"description"`
+}
+
+func ToEntryView(entry history.Entry) EntryView {
+	return EntryView{
+		ID:          entry.ID,
+		Service:     entry.Service,
+		Description: entry.Description,
+	}
+}
+
+func ToEntryViews(entries []history.Entry) []EntryView {
+	views := make([]EntryView, len(entries))
+	for i, entry := range entries {
+		views[i] = ToEntryView(entry)
+	}
+	return views
+}
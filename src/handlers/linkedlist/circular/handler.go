@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	linkedlist "golabs/src/services/linkedlist/circular"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CircularLinkedListHandler struct {
+	circularLinkedListService linkedlist.CircularLinkedListService
+}
+
+func NewCircularSingleLinkedListHandler() *CircularLinkedListHandler {
+	return &CircularLinkedListHandler{
+		circularLinkedListService: linkedlist.NewCircularSingleLinkedList(),
+	}
+}
+
+func NewCircularDoubleLinkedListHandler() *CircularLinkedListHandler {
+	return &CircularLinkedListHandler{
+		circularLinkedListService: linkedlist.NewCircularDoubleLinkedList(),
+	}
+}
+
+func (handler *CircularLinkedListHandler) AddFirst(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.circularLinkedListService.AddFirst(request.Value)
+
+	c.JSON(200, gin.H{
+		"status": "node added to head",
+		"value":  request.Value,
+	})
+}
+
+func (handler *CircularLinkedListHandler) AddLast(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.circularLinkedListService.AddLast(request.Value)
+
+	c.JSON(200, gin.H{
+		"status": "node added to tail",
+		"value":  request.Value,
+	})
+}
+
+func (handler *CircularLinkedListHandler) Clear(c *gin.Context) {
+	handler.circularLinkedListService.Clear()
+
+	c.JSON(200, gin.H{"status": "list cleared"})
+}
+
+func (handler *CircularLinkedListHandler) Remove(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	value, err := handler.circularLinkedListService.Remove(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+}
+
+func (handler *CircularLinkedListHandler) RemoveFirst(c *gin.Context) {
+	value, err := handler.circularLinkedListService.RemoveFirst()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+}
+
+func (handler *CircularLinkedListHandler) RemoveLast(c *gin.Context) {
+	value, err := handler.circularLinkedListService.RemoveLast()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+}
+
+func (handler *CircularLinkedListHandler) Rotate(c *gin.Context) {
+	var request RotateRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.circularLinkedListService.Rotate(*request.K); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list rotated successfully",
+		"value":  handler.circularLinkedListService.ToSlice(),
+	})
+}
+
+func (handler *CircularLinkedListHandler) Josephus(c *gin.Context) {
+	var request JosephusRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	order, err := handler.circularLinkedListService.Josephus(request.Step)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":   "elimination completed",
+		"value":    order,
+		"survivor": order[len(order)-1],
+	})
+}
+
+func (handler *CircularLinkedListHandler) Walk(c *gin.Context) {
+	var params GetWalk
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list walked",
+		"value":  handler.circularLinkedListService.Walk(params.Steps),
+	})
+}
+
+func (handler *CircularLinkedListHandler) List(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "list retrieved",
+		"value":  handler.circularLinkedListService.ToSlice(),
+	})
+}
+
+func (handler *CircularLinkedListHandler) Size(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"size":   handler.circularLinkedListService.Size(),
+	})
+}
@@ -0,0 +1,17 @@
+package handlers
+
+type NodeValue struct {
+	Value string `json:"value" binding:"required"`
+}
+
+type RotateRequest struct {
+	K *int `json:"k" binding:"required"`
+}
+
+type JosephusRequest struct {
+	Step int `json:"step" binding:"required,gte=1"`
+}
+
+type GetWalk struct {
+	Steps int `form:"steps" binding:"required,gte=1,lte=1000"`
+}
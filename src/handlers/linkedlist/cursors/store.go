@@ -0,0 +1,107 @@
+package cursors
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTTL = 5 * time.Minute
+	MaxTTL     = time.Hour
+)
+
+var ErrNotFound = errors.New("cursor not found or expired")
+
+// Store keeps server side cursors for the linked list handlers. Ids are
+// random so clients cannot guess each other's cursors, and every use pushes
+// the expiry back by the cursor's ttl. Expired cursors are dropped lazily.
+type Store[T any] struct {
+	mu      sync.Mutex
+	entries map[string]*entry[T]
+	now     func() time.Time
+}
+
+type entry[T any] struct {
+	item      T
+	ttl       time.Duration
+	expiresAt time.Time
+}
+
+func NewStore[T any]() *Store[T] {
+	return &Store[T]{
+		entries: map[string]*entry[T]{},
+		now:     time.Now,
+	}
+}
+
+// Open stores item and returns its id. A ttl outside (0, MaxTTL] falls back
+// to DefaultTTL.
+func (s *Store[T]) Open(item T, ttl time.Duration) (id string, expiresAt time.Time) {
+	if ttl <= 0 || ttl > MaxTTL {
+		ttl = DefaultTTL
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpired()
+
+	id = newID()
+	expiresAt = s.now().Add(ttl)
+	s.entries[id] = &entry[T]{item: item, ttl: ttl, expiresAt: expiresAt}
+
+	return id, expiresAt
+}
+
+// Get returns the item stored under id and extends its expiry.
+func (s *Store[T]) Get(id string) (item T, expiresAt time.Time, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found, ok := s.entries[id]
+	if !ok || !s.now().Before(found.expiresAt) {
+		delete(s.entries, id)
+		return item, time.Time{}, ErrNotFound
+	}
+
+	found.expiresAt = s.now().Add(found.ttl)
+
+	return found.item, found.expiresAt, nil
+}
+
+// Close forgets the cursor stored under id.
+func (s *Store[T]) Close(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[id]; !ok {
+		return ErrNotFound
+	}
+
+	delete(s.entries, id)
+
+	return nil
+}
+
+/* Private Methods */
+
+func (s *Store[T]) purgeExpired() {
+	now := s.now()
+	for id, found := range s.entries {
+		if !now.Before(found.expiresAt) {
+			delete(s.entries, id)
+		}
+	}
+}
+
+/* Utils */
+
+func newID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+
+	return hex.EncodeToString(buf)
+}
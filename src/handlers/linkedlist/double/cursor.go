@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"golabs/src/handlers/linkedlist/cursors"
+	linkedlist "golabs/src/services/linkedlist/double"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenCursor opens a cursor over the ?list= list; it stays bound to that
+// list even if the name is later reused.
+func (handler *DoubleLinkedListHandler) OpenCursor(c *gin.Context) {
+	var request CursorOpen
+
+	// The body is optional; an empty one keeps the default ttl.
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	it := handler.list(c).Iterator()
+	id, expiresAt := handler.cursors.Open(it, time.Duration(request.TTLSeconds)*time.Second)
+
+	c.JSON(201, gin.H{
+		"status": "cursor opened",
+		"value":  ToCursorView(id, it, expiresAt),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) GetCursor(c *gin.Context) {
+	handler.withCursor(c, "cursor found", handler.editing.RLocker(), func(it linkedlist.Iterator) error {
+		return nil
+	})
+}
+
+func (handler *DoubleLinkedListHandler) CursorNext(c *gin.Context) {
+	handler.withCursor(c, "cursor moved", &handler.editing, func(it linkedlist.Iterator) error {
+		_, err := it.Next()
+		return err
+	})
+}
+
+func (handler *DoubleLinkedListHandler) CursorPrev(c *gin.Context) {
+	handler.withCursor(c, "cursor moved", &handler.editing, func(it linkedlist.Iterator) error {
+		_, err := it.Prev()
+		return err
+	})
+}
+
+func (handler *DoubleLinkedListHandler) CursorInsertBefore(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.withCursor(c, "node inserted before cursor", &handler.editing, handler.edited(func(it linkedlist.Iterator) error {
+		return it.InsertBefore(request.Value)
+	}))
+}
+
+func (handler *DoubleLinkedListHandler) CursorInsertAfter(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.withCursor(c, "node inserted after cursor", &handler.editing, handler.edited(func(it linkedlist.Iterator) error {
+		return it.InsertAfter(request.Value)
+	}))
+}
+
+func (handler *DoubleLinkedListHandler) CursorRemove(c *gin.Context) {
+	handler.withCursor(c, "node removed at cursor", &handler.editing, handler.edited(func(it linkedlist.Iterator) error {
+		_, err := it.Remove()
+		return err
+	}))
+}
+
+func (handler *DoubleLinkedListHandler) CloseCursor(c *gin.Context) {
+	var params GetCursor
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.cursors.Close(params.ID); err != nil {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{"status": "cursor closed"})
+}
+
+// withCursor resolves the ?id= cursor, applies step to it and responds with
+// the cursor's new position, all under lock. Moving a cursor changes its
+// iterator, so only a step that just looks gets the read side of editing.
+func (handler *DoubleLinkedListHandler) withCursor(c *gin.Context, status string, lock sync.Locker, step func(it linkedlist.Iterator) error) {
+	var params GetCursor
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	it, expiresAt, err := handler.cursors.Get(params.ID)
+
+	if errors.Is(err, cursors.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := step(it); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": status,
+		"value":  ToCursorView(params.ID, it, expiresAt),
+	})
+}
+
+// edited wraps a step that changes the list. Cursor edits are not recorded,
+// so a successful one forgets the list history.
+func (handler *DoubleLinkedListHandler) edited(step func(it linkedlist.Iterator) error) func(it linkedlist.Iterator) error {
+	return func(it linkedlist.Iterator) error {
+		if err := step(it); err != nil {
+			return err
+		}
+
+		handler.history.Forget(historyName)
+
+		return nil
+	}
+}
@@ -1,18 +1,36 @@
 package handlers
 
 import (
+	"fmt"
+	"sync"
+
+	"golabs/src/handlers/linkedlist/cursors"
+	"golabs/src/services/history"
 	linkedlist "golabs/src/services/linkedlist/double"
 
 	"github.com/gin-gonic/gin"
 )
 
+// DoubleLinkedListHandler keeps a set of named lists so the relinking routes
+// can move nodes between them. Every route accepts an optional ?list= query
+// and falls back to the default list. mu guards the registry; editing is
+// held across each mutation and its history record, and by the recorded
+// commands when history runs them. Read routes share editing.
 type DoubleLinkedListHandler struct {
-	doubleLinkedListService linkedlist.DoubleLinkedListService
+	mu      sync.Mutex
+	editing sync.RWMutex
+	lists   map[string]linkedlist.DoubleLinkedListService
+	cursors *cursors.Store[linkedlist.Iterator]
+	history history.HistoryService
 }
 
-func NewDoubleLinkedListHandler() *DoubleLinkedListHandler {
+func NewDoubleLinkedListHandler(historyService history.HistoryService) *DoubleLinkedListHandler {
 	return &DoubleLinkedListHandler{
-		doubleLinkedListService: linkedlist.NewDoubleLinkedList(),
+		lists: map[string]linkedlist.DoubleLinkedListService{
+			DefaultList: linkedlist.NewDoubleLinkedList(),
+		},
+		cursors: cursors.NewStore[linkedlist.Iterator](),
+		history: historyService,
 	}
 }
 
@@ -24,7 +42,22 @@ func (handler *DoubleLinkedListHandler) AddFirst(c *gin.Context) {
 		return
 	}
 
-	handler.doubleLinkedListService.AddFirst(request.Value)
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	list.AddFirst(request.Value)
+
+	handler.record(c, fmt.Sprintf("add-first %q", request.Value),
+		func() error {
+			_, err := list.RemoveFirst()
+			return err
+		},
+		func() error {
+			list.AddFirst(request.Value)
+			return nil
+		},
+	)
 
 	c.JSON(200, gin.H{
 		"status": "node added to head",
@@ -40,7 +73,22 @@ func (handler *DoubleLinkedListHandler) AddLast(c *gin.Context) {
 		return
 	}
 
-	handler.doubleLinkedListService.AddLast(request.Value)
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	list.AddLast(request.Value)
+
+	handler.record(c, fmt.Sprintf("add-last %q", request.Value),
+		func() error {
+			_, err := list.RemoveLast()
+			return err
+		},
+		func() error {
+			list.AddLast(request.Value)
+			return nil
+		},
+	)
 
 	c.JSON(200, gin.H{
 		"status": "node added to tail",
@@ -49,7 +97,25 @@ func (handler *DoubleLinkedListHandler) AddLast(c *gin.Context) {
 }
 
 func (handler *DoubleLinkedListHandler) Clear(c *gin.Context) {
-	handler.doubleLinkedListService.Clear()
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	values := list.ToSlice()
+	list.Clear()
+
+	handler.record(c, fmt.Sprintf("clear %d nodes", len(values)),
+		func() error {
+			for _, value := range values {
+				list.AddLast(value)
+			}
+			return nil
+		},
+		func() error {
+			list.Clear()
+			return nil
+		},
+	)
 
 	c.JSON(200, gin.H{"status": "list cleared"})
 }
@@ -62,7 +128,10 @@ func (handler *DoubleLinkedListHandler) Find(c *gin.Context) {
 		return
 	}
 
-	node, err := handler.doubleLinkedListService.Find(request.Value)
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	node, err := handler.list(c).Find(request.Value)
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
@@ -88,7 +157,10 @@ func (handler *DoubleLinkedListHandler) GetAt(c *gin.Context) {
 		return
 	}
 
-	node, err := handler.doubleLinkedListService.GetAt(*params.Index)
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	node, err := handler.list(c).GetAt(*params.Index)
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
@@ -115,7 +187,68 @@ func (handler *DoubleLinkedListHandler) IndexOf(c *gin.Context) {
 		return
 	}
 
-	nodeIndex, err := handler.doubleLinkedListService.IndexOf(request.Value)
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	nodeIndex, err := handler.list(c).IndexOf(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	if nodeIndex == -1 {
+		c.JSON(404, gin.H{"error": "node not found"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  nodeIndex,
+	})
+}
+
+func (handler *DoubleLinkedListHandler) FindLast(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	node, err := handler.list(c).FindLast(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	if node == nil {
+		c.JSON(404, gin.H{"error": "node not found"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  ToNodeView(node),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) LastIndexOf(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	nodeIndex, err := handler.list(c).LastIndexOf(request.Value)
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
@@ -141,11 +274,20 @@ func (handler *DoubleLinkedListHandler) InsertAfter(c *gin.Context) {
 		return
 	}
 
-	if err := handler.doubleLinkedListService.InsertAfter(request.SearchValue, request.Value); err != nil {
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	if err := list.InsertAfter(request.SearchValue, request.Value); err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	// The new node sits right after the first match, which is still the
+	// first match after the insert.
+	searchIndex, _ := list.IndexOf(request.SearchValue)
+	handler.recordInsert(c, fmt.Sprintf("insert %q after %q", request.Value, request.SearchValue), list, searchIndex+1, request.Value)
+
 	c.JSON(200, gin.H{
 		"status": "node inserted after successfully",
 		"value":  request,
@@ -160,11 +302,18 @@ func (handler *DoubleLinkedListHandler) InsertAt(c *gin.Context) {
 		return
 	}
 
-	if err := handler.doubleLinkedListService.InsertAt(*request.Index, request.Value); err != nil {
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	index := resolve(*request.Index, list.Size())
+	if err := list.InsertAt(*request.Index, request.Value); err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	handler.recordInsert(c, fmt.Sprintf("insert %q at %d", request.Value, index), list, index, request.Value)
+
 	c.JSON(200, gin.H{
 		"status": "node inserted at successfully",
 		"value":  request,
@@ -179,13 +328,20 @@ func (handler *DoubleLinkedListHandler) Remove(c *gin.Context) {
 		return
 	}
 
-	node, err := handler.doubleLinkedListService.Remove(request.Value)
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	index, _ := list.IndexOf(request.Value)
+	node, err := list.Remove(request.Value)
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	handler.recordRemove(c, fmt.Sprintf("remove %q", node), list, index, node)
+
 	c.JSON(200, gin.H{
 		"status": "node removed successfully",
 		"value":  node,
@@ -200,13 +356,20 @@ func (handler *DoubleLinkedListHandler) RemoveAt(c *gin.Context) {
 		return
 	}
 
-	node, err := handler.doubleLinkedListService.RemoveAt(*params.Index)
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	index := resolve(*params.Index, list.Size())
+	node, err := list.RemoveAt(*params.Index)
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	handler.recordRemove(c, fmt.Sprintf("remove %q at %d", node, index), list, index, node)
+
 	c.JSON(200, gin.H{
 		"status": "node removed successfully",
 		"value":  node,
@@ -215,13 +378,19 @@ func (handler *DoubleLinkedListHandler) RemoveAt(c *gin.Context) {
 }
 
 func (handler *DoubleLinkedListHandler) RemoveFirst(c *gin.Context) {
-	node, err := handler.doubleLinkedListService.RemoveFirst()
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	node, err := list.RemoveFirst()
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	handler.recordRemove(c, fmt.Sprintf("remove-first %q", node), list, 0, node)
+
 	c.JSON(200, gin.H{
 		"status": "node removed successfully",
 		"value":  node,
@@ -230,16 +399,80 @@ func (handler *DoubleLinkedListHandler) RemoveFirst(c *gin.Context) {
 }
 
 func (handler *DoubleLinkedListHandler) RemoveLast(c *gin.Context) {
-	node, err := handler.doubleLinkedListService.RemoveLast()
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	list := handler.list(c)
+	index := list.Size() - 1
+	node, err := list.RemoveLast()
 
 	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	handler.recordRemove(c, fmt.Sprintf("remove-last %q", node), list, index, node)
+
 	c.JSON(200, gin.H{
 		"status": "node removed successfully",
 		"value":  node,
 	})
 
 }
+
+func (handler *DoubleLinkedListHandler) Size(c *gin.Context) {
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"size":   handler.list(c).Size(),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) List(c *gin.Context) {
+	var params ListPage
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	var values []string
+	if params.Direction == "backward" {
+		values = handler.list(c).ToSliceReverse()
+	} else {
+		values = handler.list(c).ToSlice()
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list retrieved",
+		"value":  paginate(values, params.Offset, params.Limit),
+		"offset": params.Offset,
+		"size":   len(values),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) View(c *gin.Context) {
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	forward := handler.list(c).ToSlice()
+	backward := handler.list(c).ToSliceReverse()
+
+	consistent := len(forward) == len(backward)
+	for i := 0; consistent && i < len(forward); i++ {
+		consistent = forward[i] == backward[len(backward)-1-i]
+	}
+
+	c.JSON(200, gin.H{
+		"status":     "list retrieved",
+		"forward":    forward,
+		"backward":   backward,
+		"size":       handler.list(c).Size(),
+		"consistent": consistent,
+	})
+}
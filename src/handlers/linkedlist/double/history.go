@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"fmt"
+
+	"golabs/src/services/history"
+	linkedlist "golabs/src/services/linkedlist/double"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Only the single-node mutations and Clear are recorded. Relinking and
+// cursor edits move nodes between lists and positions in ways a positional
+// inverse cannot describe, so they forget the recorded history instead.
+const historyName = "double-linked-list"
+
+// record must be called with editing held; the commands take it again when
+// history runs them.
+func (handler *DoubleLinkedListHandler) record(c *gin.Context, description string, undo func() error, redo func() error) {
+	handler.history.Record(history.Command{
+		Service:     historyName,
+		Description: fmt.Sprintf("%s on list %q", description, c.DefaultQuery("list", DefaultList)),
+		Undo:        handler.locked(undo),
+		Redo:        handler.locked(redo),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) recordInsert(c *gin.Context, description string, list linkedlist.DoubleLinkedListService, index int, value string) {
+	handler.record(c, description,
+		func() error {
+			_, err := list.RemoveAt(index)
+			return err
+		},
+		func() error {
+			return list.InsertAt(index, value)
+		},
+	)
+}
+
+func (handler *DoubleLinkedListHandler) recordRemove(c *gin.Context, description string, list linkedlist.DoubleLinkedListService, index int, value string) {
+	handler.record(c, description,
+		func() error {
+			return list.InsertAt(index, value)
+		},
+		func() error {
+			_, err := list.RemoveAt(index)
+			return err
+		},
+	)
+}
+
+// locked wraps a history callback so it runs under editing.
+func (handler *DoubleLinkedListHandler) locked(run func() error) func() error {
+	return func() error {
+		handler.editing.Lock()
+		defer handler.editing.Unlock()
+
+		return run()
+	}
+}
+
+// resolve mirrors the service's handling of negative indices so the
+// recorded position is the one the operation actually touched.
+func resolve(index int, size int) int {
+	if index < 0 {
+		return index + size
+	}
+	return index
+}
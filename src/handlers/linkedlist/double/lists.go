@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"errors"
+	"sort"
+
+	linkedlist "golabs/src/services/linkedlist/double"
+
+	"github.com/gin-gonic/gin"
+)
+
+const DefaultList = "default"
+
+var ErrListInUse = errors.New("double linked list name is already in use")
+
+func (handler *DoubleLinkedListHandler) Lists(c *gin.Context) {
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	names := make([]string, 0, len(handler.lists))
+	for name := range handler.lists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	views := make([]ListView, 0, len(names))
+	for _, name := range names {
+		views = append(views, ListView{Name: name, Size: handler.lists[name].Size()})
+	}
+
+	c.JSON(200, gin.H{
+		"status": "lists retrieved",
+		"value":  views,
+	})
+}
+
+func (handler *DoubleLinkedListHandler) Concat(c *gin.Context) {
+	var request ListConcat
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	target := handler.named(request.Target)
+	if err := target.Concat(handler.named(request.Source)); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+	handler.history.Forget(historyName)
+
+	c.JSON(200, gin.H{
+		"status": "lists concatenated",
+		"value":  target.ToSlice(),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) SplitAt(c *gin.Context) {
+	var request ListSplitAt
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	if existing, found := handler.lists[request.Target]; found && existing.Size() > 0 {
+		c.JSON(409, gin.H{"error": ErrListInUse.Error()})
+		return
+	}
+
+	source := handler.namedLocked(request.Source)
+	suffix, err := source.SplitAt(*request.Index)
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	handler.lists[request.Target] = suffix
+	handler.history.Forget(historyName)
+
+	c.JSON(200, gin.H{
+		"status": "list split",
+		"value": gin.H{
+			request.Source: source.ToSlice(),
+			request.Target: suffix.ToSlice(),
+		},
+	})
+}
+
+func (handler *DoubleLinkedListHandler) Splice(c *gin.Context) {
+	var request ListSplice
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.Lock()
+	defer handler.editing.Unlock()
+
+	target := handler.named(request.Target)
+	if err := target.Splice(*request.Index, handler.named(request.Source)); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+	handler.history.Forget(historyName)
+
+	c.JSON(200, gin.H{
+		"status": "list spliced",
+		"value":  target.ToSlice(),
+	})
+}
+
+func (handler *DoubleLinkedListHandler) Sublist(c *gin.Context) {
+	var params GetSublist
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	handler.editing.RLock()
+	defer handler.editing.RUnlock()
+
+	sublist, err := handler.list(c).Sublist(*params.From, *params.To)
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "sublist retrieved",
+		"value":  sublist.ToSlice(),
+	})
+}
+
+// list resolves the ?list= query, creating the list on first use.
+func (handler *DoubleLinkedListHandler) list(c *gin.Context) linkedlist.DoubleLinkedListService {
+	return handler.named(c.DefaultQuery("list", DefaultList))
+}
+
+func (handler *DoubleLinkedListHandler) named(name string) linkedlist.DoubleLinkedListService {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	return handler.namedLocked(name)
+}
+
+func (handler *DoubleLinkedListHandler) namedLocked(name string) linkedlist.DoubleLinkedListService {
+	list, found := handler.lists[name]
+	if !found {
+		list = linkedlist.NewDoubleLinkedList()
+		handler.lists[name] = list
+	}
+
+	return list
+}
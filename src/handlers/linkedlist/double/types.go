@@ -1,13 +1,17 @@
 package handlers
 
-import service "golabs/src/services/linkedlist/double"
+import (
+	"time"
+
+	service "golabs/src/services/linkedlist/double"
+)
 
 type NodeValue struct {
 	Value string `json:"value" binding:"required"`
 }
 
 type GetNodeIndex struct {
-	Index *int `form:"index" binding:"required,gte=0"`
+	Index *int `form:"index" binding:"required"`
 }
 
 type NodeInsert struct {
@@ -16,7 +20,7 @@ type NodeInsert struct {
 }
 
 type NodeInsertAt struct {
-	Index *int   `json:"index" binding:"required,gte=0"`
+	Index *int   `json:"index" binding:"required"`
 	Value string `json:"value" binding:"required"`
 }
 
@@ -43,3 +47,87 @@ func ToNodeView(node *service.Node) *NodeView {
 		Next:  next,
 	}
 }
+
+type ListPage struct {
+	Offset    int    `form:"offset" binding:"gte=0"`
+	Limit     int    `form:"limit" binding:"gte=0"`
+	Direction string `form:"direction" binding:"omitempty,oneof=forward backward"`
+}
+
+// paginate returns the values in [offset, offset+limit); a zero limit
+// defaults to 20.
+func paginate(values []string, offset int, limit int) []string {
+	if limit == 0 {
+		limit = 20
+	}
+
+	if offset >= len(values) {
+		return []string{}
+	}
+
+	end := offset + limit
+	if end > len(values) {
+		end = len(values)
+	}
+
+	return values[offset:end]
+}
+
+type ListView struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+type ListConcat struct {
+	Target string `json:"target" binding:"required"`
+	Source string `json:"source" binding:"required"`
+}
+
+type ListSplitAt struct {
+	Source string `json:"source" binding:"required"`
+	Index  *int   `json:"index" binding:"required"`
+	Target string `json:"target" binding:"required"`
+}
+
+type ListSplice struct {
+	Target string `json:"target" binding:"required"`
+	Index  *int   `json:"index" binding:"required"`
+	Source string `json:"source" binding:"required"`
+}
+
+type GetSublist struct {
+	From *int `form:"from" binding:"required"`
+	To   *int `form:"to" binding:"required"`
+}
+
+type CursorOpen struct {
+	TTLSeconds int `json:"ttlSeconds" binding:"omitempty,gte=1,lte=3600"`
+}
+
+type GetCursor struct {
+	ID string `form:"id" binding:"required"`
+}
+
+type CursorView struct {
+	ID        string    `json:"id"`
+	Index     int       `json:"index"`
+	Value     *string   `json:"value"`
+	HasNext   bool      `json:"hasNext"`
+	HasPrev   bool      `json:"hasPrev"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func ToCursorView(id string, it service.Iterator, expiresAt time.Time) CursorView {
+	var value *string
+	if current, err := it.Current(); err == nil {
+		value = &current
+	}
+	return CursorView{
+		ID:        id,
+		Index:     it.Index(),
+		Value:     value,
+		HasNext:   it.HasNext(),
+		HasPrev:   it.HasPrev(),
+		ExpiresAt: expiresAt,
+	}
+}
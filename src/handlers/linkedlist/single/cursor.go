@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"golabs/src/handlers/linkedlist/cursors"
+	linkedlist "golabs/src/services/linkedlist/single"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (handler *SingleLinkedListHandler) OpenCursor(c *gin.Context) {
+	var request CursorOpen
+
+	// The body is optional; an empty one keeps the default ttl.
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "datails": err.Error()})
+		return
+	}
+
+	it := handler.singleLinkedListService.Iterator()
+	id, expiresAt := handler.cursors.Open(it, time.Duration(request.TTLSeconds)*time.Second)
+
+	c.JSON(201, gin.H{
+		"status": "cursor opened",
+		"value":  ToCursorView(id, it, expiresAt),
+	})
+}
+
+func (handler *SingleLinkedListHandler) GetCursor(c *gin.Context) {
+	handler.withCursor(c, "cursor found", func(it linkedlist.Iterator) error {
+		return nil
+	})
+}
+
+func (handler *SingleLinkedListHandler) CursorNext(c *gin.Context) {
+	handler.withCursor(c, "cursor moved", func(it linkedlist.Iterator) error {
+		_, err := it.Next()
+		return err
+	})
+}
+
+func (handler *SingleLinkedListHandler) CursorInsertBefore(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "datails": err.Error()})
+		return
+	}
+
+	handler.withCursor(c, "node inserted before cursor", func(it linkedlist.Iterator) error {
+		return it.InsertBefore(request.Value)
+	})
+}
+
+func (handler *SingleLinkedListHandler) CursorInsertAfter(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "datails": err.Error()})
+		return
+	}
+
+	handler.withCursor(c, "node inserted after cursor", func(it linkedlist.Iterator) error {
+		return it.InsertAfter(request.Value)
+	})
+}
+
+func (handler *SingleLinkedListHandler) CursorRemove(c *gin.Context) {
+	handler.withCursor(c, "node removed at cursor", func(it linkedlist.Iterator) error {
+		_, err := it.Remove()
+		return err
+	})
+}
+
+func (handler *SingleLinkedListHandler) CloseCursor(c *gin.Context) {
+	var params GetCursor
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.cursors.Close(params.ID); err != nil {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{"status": "cursor closed"})
+}
+
+// withCursor resolves the ?id= cursor, applies step to it and responds with
+// the cursor's new position.
+func (handler *SingleLinkedListHandler) withCursor(c *gin.Context, status string, step func(it linkedlist.Iterator) error) {
+	var params GetCursor
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	it, expiresAt, err := handler.cursors.Get(params.ID)
+
+	if errors.Is(err, cursors.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := step(it); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": status,
+		"value":  ToCursorView(params.ID, it, expiresAt),
+	})
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"golabs/src/handlers/linkedlist/cursors"
 	linkedlist "golabs/src/services/linkedlist/single"
 
 	"github.com/gin-gonic/gin"
@@ -8,11 +9,13 @@ import (
 
 type SingleLinkedListHandler struct {
 	singleLinkedListService linkedlist.SingleLinkedListService
+	cursors                 *cursors.Store[linkedlist.Iterator]
 }
 
 func NewSingleLinkedListHandler() *SingleLinkedListHandler {
 	return &SingleLinkedListHandler{
 		singleLinkedListService: linkedlist.NewSingleLinkedList(),
+		cursors:                 cursors.NewStore[linkedlist.Iterator](),
 	}
 }
 
@@ -78,7 +81,7 @@ func (handler *SingleLinkedListHandler) Find(c *gin.Context) {
 
 	c.JSON(200, gin.H{
 		"status": "node found",
-		"value":  node,
+		"value":  ToNodeView(node),
 	})
 
 }
@@ -105,7 +108,7 @@ func (handler *SingleLinkedListHandler) GetAt(c *gin.Context) {
 
 	c.JSON(200, gin.H{
 		"status": "node found",
-		"value":  response,
+		"value":  ToNodeView(response),
 	})
 
 }
@@ -221,3 +224,137 @@ func (handler *SingleLinkedListHandler) RemoveFirst(c *gin.Context) {
 		"value":  response,
 	})
 }
+
+func (handler *SingleLinkedListHandler) RemoveAll(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "datails": err.Error()})
+		return
+	}
+
+	removed, err := handler.singleLinkedListService.RemoveAll(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":  "nodes removed successfully",
+		"removed": removed,
+	})
+}
+
+func (handler *SingleLinkedListHandler) Dedupe(c *gin.Context) {
+	removed, err := handler.singleLinkedListService.Dedupe()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":  "duplicates removed successfully",
+		"removed": removed,
+	})
+}
+
+func (handler *SingleLinkedListHandler) Reverse(c *gin.Context) {
+	if err := handler.singleLinkedListService.Reverse(); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list reversed successfully",
+	})
+}
+
+func (handler *SingleLinkedListHandler) Sort(c *gin.Context) {
+	if err := handler.singleLinkedListService.Sort(); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list sorted successfully",
+	})
+}
+
+func (handler *SingleLinkedListHandler) Size(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"size":   handler.singleLinkedListService.Size(),
+	})
+}
+
+func (handler *SingleLinkedListHandler) List(c *gin.Context) {
+	var params ListPage
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	values := handler.singleLinkedListService.ToSlice()
+
+	c.JSON(200, gin.H{
+		"status": "list retrieved",
+		"value":  paginate(values, params.Offset, params.Limit),
+		"offset": params.Offset,
+		"size":   len(values),
+	})
+}
+
+func (handler *SingleLinkedListHandler) CreateCycle(c *gin.Context) {
+	var request NodeIndex
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "datails": err.Error()})
+		return
+	}
+
+	if err := handler.singleLinkedListService.CreateCycle(*request.Index); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "tail linked back to index",
+		"value":  *request.Index,
+	})
+}
+
+func (handler *SingleLinkedListHandler) BreakCycle(c *gin.Context) {
+	if err := handler.singleLinkedListService.BreakCycle(); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "cycle broken successfully",
+	})
+}
+
+func (handler *SingleLinkedListHandler) DetectCycle(c *gin.Context) {
+	var params GetCycle
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	var cycle linkedlist.Cycle
+	if params.Algorithm == "brent" {
+		cycle = handler.singleLinkedListService.DetectCycleBrent()
+	} else {
+		params.Algorithm = "floyd"
+		cycle = handler.singleLinkedListService.DetectCycleFloyd()
+	}
+
+	c.JSON(200, gin.H{
+		"status": "cycle detection completed",
+		"value":  ToCycleView(params.Algorithm, cycle),
+	})
+}
@@ -1,5 +1,11 @@
 package handlers
 
+import (
+	"time"
+
+	service "golabs/src/services/linkedlist/single"
+)
+
 type NodeValue struct {
 	Value string `json:"value" binding:"required"`
 }
@@ -17,3 +23,104 @@ type NodeInsertAfter struct {
 	SearchValue string `json:"searchValue" binding:"required,gte=0"`
 	Value       string `json:"value" binding:"required"`
 }
+
+type NodeView struct {
+	Value string  `json:"data"`
+	Next  *string `json:"next,omitempty"`
+}
+
+// ToNodeView keeps only the neighbour's value; encoding the node itself would
+// follow Next through the rest of the list, forever once a cycle exists.
+func ToNodeView(node *service.Node) *NodeView {
+	if node == nil {
+		return nil
+	}
+	var next *string
+	if node.Next != nil {
+		next = &node.Next.Value
+	}
+	return &NodeView{
+		Value: node.Value,
+		Next:  next,
+	}
+}
+
+type GetCycle struct {
+	Algorithm string `form:"algorithm" binding:"omitempty,oneof=floyd brent"`
+}
+
+type CycleView struct {
+	Algorithm string `json:"algorithm"`
+	Found     bool   `json:"found"`
+	Start     int    `json:"start"`
+	Length    int    `json:"length"`
+	Steps     int    `json:"steps"`
+}
+
+func ToCycleView(algorithm string, cycle service.Cycle) CycleView {
+	view := CycleView{
+		Algorithm: algorithm,
+		Found:     cycle.Found,
+		Start:     -1,
+		Length:    cycle.Length,
+		Steps:     cycle.Steps,
+	}
+	if cycle.Found {
+		view.Start = cycle.Start
+	}
+	return view
+}
+
+type ListPage struct {
+	Offset int `form:"offset" binding:"gte=0"`
+	Limit  int `form:"limit" binding:"gte=0"`
+}
+
+// paginate returns the values in [offset, offset+limit); a zero limit
+// defaults to 20.
+func paginate(values []string, offset int, limit int) []string {
+	if limit == 0 {
+		limit = 20
+	}
+
+	if offset >= len(values) {
+		return []string{}
+	}
+
+	end := offset + limit
+	if end > len(values) {
+		end = len(values)
+	}
+
+	return values[offset:end]
+}
+
+type CursorOpen struct {
+	TTLSeconds int `json:"ttlSeconds" binding:"omitempty,gte=1,lte=3600"`
+}
+
+type GetCursor struct {
+	ID string `form:"id" binding:"required"`
+}
+
+type CursorView struct {
+	ID        string    `json:"id"`
+	Index     int       `json:"index"`
+	Value     *string   `json:"value"`
+	HasNext   bool      `json:"hasNext"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func ToCursorView(id string, it service.Iterator, expiresAt time.Time) CursorView {
+	var value *string
+	if current, err := it.Current(); err == nil {
+		value = &current
+	}
+	return CursorView{
+		ID:        id,
+		Index:     it.Index(),
+		Value:     value,
+		HasNext:   it.HasNext(),
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,375 @@
+package handlers
+
+import (
+	linkedlist "golabs/src/services/linkedlist/unrolled"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UnrolledLinkedListHandler struct {
+	unrolledLinkedListService linkedlist.UnrolledLinkedListService
+}
+
+func NewUnrolledLinkedListHandler() *UnrolledLinkedListHandler {
+	return &UnrolledLinkedListHandler{
+		unrolledLinkedListService: linkedlist.NewUnrolledLinkedList(),
+	}
+}
+
+func (handler *UnrolledLinkedListHandler) Initialize(c *gin.Context) {
+	var request InitializeRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.unrolledLinkedListService.Initialize(request.Capacity); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "initialized",
+		"value":  request.Capacity,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) AddFirst(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.unrolledLinkedListService.AddFirst(request.Value)
+
+	c.JSON(200, gin.H{
+		"status": "node added to head",
+		"value":  request.Value,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) AddLast(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.unrolledLinkedListService.AddLast(request.Value)
+
+	c.JSON(200, gin.H{
+		"status": "node added to tail",
+		"value":  request.Value,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) Clear(c *gin.Context) {
+	handler.unrolledLinkedListService.Clear()
+
+	c.JSON(200, gin.H{"status": "list cleared"})
+}
+
+func (handler *UnrolledLinkedListHandler) Find(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	element, err := handler.unrolledLinkedListService.Find(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  ToElementView(element),
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) GetAt(c *gin.Context) {
+	var params GetNodeIndex
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	element, err := handler.unrolledLinkedListService.GetAt(*params.Index)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  ToElementView(element),
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) IndexOf(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	nodeIndex, err := handler.unrolledLinkedListService.IndexOf(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	if nodeIndex == -1 {
+		c.JSON(404, gin.H{"error": "node not found"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  nodeIndex,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) FindLast(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	element, err := handler.unrolledLinkedListService.FindLast(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  ToElementView(element),
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) LastIndexOf(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	nodeIndex, err := handler.unrolledLinkedListService.LastIndexOf(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	if nodeIndex == -1 {
+		c.JSON(404, gin.H{"error": "node not found"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"value":  nodeIndex,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) InsertAfter(c *gin.Context) {
+	var request NodeInsert
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.unrolledLinkedListService.InsertAfter(request.SearchValue, request.Value); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node inserted after successfully",
+		"value":  request,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) InsertAt(c *gin.Context) {
+	var request NodeInsertAt
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.unrolledLinkedListService.InsertAt(*request.Index, request.Value); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node inserted at successfully",
+		"value":  request,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) Remove(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	value, err := handler.unrolledLinkedListService.Remove(request.Value)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) RemoveAt(c *gin.Context) {
+	var params GetNodeIndex
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	value, err := handler.unrolledLinkedListService.RemoveAt(*params.Index)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+
+}
+
+func (handler *UnrolledLinkedListHandler) RemoveFirst(c *gin.Context) {
+	value, err := handler.unrolledLinkedListService.RemoveFirst()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+
+}
+
+func (handler *UnrolledLinkedListHandler) RemoveLast(c *gin.Context) {
+	value, err := handler.unrolledLinkedListService.RemoveLast()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+
+}
+
+func (handler *UnrolledLinkedListHandler) Size(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"size":   handler.unrolledLinkedListService.Size(),
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) List(c *gin.Context) {
+	var params ListPage
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	var values []string
+	if params.Direction == "backward" {
+		values = handler.unrolledLinkedListService.ToSliceReverse()
+	} else {
+		values = handler.unrolledLinkedListService.ToSlice()
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list retrieved",
+		"value":  paginate(values, params.Offset, params.Limit),
+		"offset": params.Offset,
+		"size":   len(values),
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) View(c *gin.Context) {
+	forward := handler.unrolledLinkedListService.ToSlice()
+	backward := handler.unrolledLinkedListService.ToSliceReverse()
+
+	consistent := len(forward) == len(backward)
+	for i := 0; consistent && i < len(forward); i++ {
+		consistent = forward[i] == backward[len(backward)-1-i]
+	}
+
+	c.JSON(200, gin.H{
+		"status":     "list retrieved",
+		"forward":    forward,
+		"backward":   backward,
+		"size":       handler.unrolledLinkedListService.Size(),
+		"consistent": consistent,
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) Sublist(c *gin.Context) {
+	var params GetSublist
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	sublist, err := handler.unrolledLinkedListService.Sublist(*params.From, *params.To)
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "sublist retrieved",
+		"value":  sublist.ToSlice(),
+	})
+}
+
+func (handler *UnrolledLinkedListHandler) Stats(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  ToStatsView(handler.unrolledLinkedListService.Stats()),
+	})
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import service "golabs/src/services/linkedlist/unrolled"
+
+type InitializeRequest struct {
+	Capacity int `json:"capacity" binding:"required,gte=2,lte=1024"`
+}
+
+type NodeValue struct {
+	Value string `json:"value" binding:"required"`
+}
+
+type GetNodeIndex struct {
+	Index *int `form:"index" binding:"required"`
+}
+
+type NodeInsert struct {
+	SearchValue string `json:"searchValue" binding:"required"`
+	Value       string `json:"value" binding:"required"`
+}
+
+type NodeInsertAt struct {
+	Index *int   `json:"index" binding:"required"`
+	Value string `json:"value" binding:"required"`
+}
+
+type ElementView struct {
+	Value string  `json:"data"`
+	Index int     `json:"index"`
+	Prev  *string `json:"prev,omitempty"`
+	Next  *string `json:"next,omitempty"`
+}
+
+func ToElementView(element service.Element) ElementView {
+	return ElementView{
+		Value: element.Value,
+		Index: element.Index,
+		Prev:  element.Prev,
+		Next:  element.Next,
+	}
+}
+
+type GetSublist struct {
+	From *int `form:"from" binding:"required"`
+	To   *int `form:"to" binding:"required"`
+}
+
+type StatsView struct {
+	Size        int       `json:"size"`
+	Capacity    int       `json:"capacity"`
+	Nodes       int       `json:"nodes"`
+	FillFactors []float64 `json:"fillFactors"`
+	AverageFill float64   `json:"averageFill"`
+	MinFill     float64   `json:"minFill"`
+	MaxFill     float64   `json:"maxFill"`
+	SavedNodes  int       `json:"savedNodes"`
+}
+
+func ToStatsView(stats service.Stats) StatsView {
+	return StatsView{
+		Size:        stats.Size,
+		Capacity:    stats.Capacity,
+		Nodes:       stats.Nodes,
+		FillFactors: stats.FillFactors,
+		AverageFill: stats.AverageFill,
+		MinFill:     stats.MinFill,
+		MaxFill:     stats.MaxFill,
+		SavedNodes:  stats.SavedNodes,
+	}
+}
+
+type ListPage struct {
+	Offset    int    `form:"offset" binding:"gte=0"`
+	Limit     int    `form:"limit" binding:"gte=0"`
+	Direction string `form:"direction" binding:"omitempty,oneof=forward backward"`
+}
+
+// paginate returns the values in [offset, offset+limit); a zero limit
+// defaults to 20.
+func paginate(values []string, offset int, limit int) []string {
+	if limit == 0 {
+		limit = 20
+	}
+
+	if offset >= len(values) {
+		return []string{}
+	}
+
+	end := offset + limit
+	if end > len(values) {
+		end = len(values)
+	}
+
+	return values[offset:end]
+}
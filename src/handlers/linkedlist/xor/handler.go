@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	linkedlist "golabs/src/services/linkedlist/xor"
+
+	"github.com/gin-gonic/gin"
+)
+
+type XorLinkedListHandler struct {
+	xorLinkedListService linkedlist.XorLinkedListService
+}
+
+func NewXorLinkedListHandler() *XorLinkedListHandler {
+	return &XorLinkedListHandler{
+		xorLinkedListService: linkedlist.NewXorLinkedList(),
+	}
+}
+
+func (handler *XorLinkedListHandler) AddFirst(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.xorLinkedListService.AddFirst(request.Value)
+
+	c.JSON(200, gin.H{
+		"status": "node added to head",
+		"value":  request.Value,
+	})
+}
+
+func (handler *XorLinkedListHandler) AddLast(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	handler.xorLinkedListService.AddLast(request.Value)
+
+	c.JSON(200, gin.H{
+		"status": "node added to tail",
+		"value":  request.Value,
+	})
+}
+
+func (handler *XorLinkedListHandler) RemoveFirst(c *gin.Context) {
+	value, err := handler.xorLinkedListService.RemoveFirst()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+}
+
+func (handler *XorLinkedListHandler) RemoveLast(c *gin.Context) {
+	value, err := handler.xorLinkedListService.RemoveLast()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node removed successfully",
+		"value":  value,
+	})
+}
+
+func (handler *XorLinkedListHandler) Clear(c *gin.Context) {
+	handler.xorLinkedListService.Clear()
+
+	c.JSON(200, gin.H{"status": "list cleared"})
+}
+
+func (handler *XorLinkedListHandler) List(c *gin.Context) {
+	var params GetList
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	var values []string
+	if params.Direction == "backward" {
+		values = handler.xorLinkedListService.ToSliceReverse()
+	} else {
+		values = handler.xorLinkedListService.ToSlice()
+	}
+
+	c.JSON(200, gin.H{
+		"status": "list retrieved",
+		"value":  values,
+	})
+}
+
+func (handler *XorLinkedListHandler) Size(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"size":   handler.xorLinkedListService.Size(),
+	})
+}
+
+func (handler *XorLinkedListHandler) Stats(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  ToStatsView(handler.xorLinkedListService.Stats()),
+	})
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import service "golabs/src/services/linkedlist/xor"
+
+type NodeValue struct {
+	Value string `json:"value" binding:"required"`
+}
+
+type GetList struct {
+	Direction string `form:"direction" binding:"omitempty,oneof=forward backward"`
+}
+
+type StatsView struct {
+	Size       int `json:"size"`
+	ArenaSlots int `json:"arenaSlots"`
+	FreeSlots  int `json:"freeSlots"`
+}
+
+func ToStatsView(stats service.Stats) StatsView {
+	return StatsView{
+		Size:       stats.Size,
+		ArenaSlots: stats.ArenaSlots,
+		FreeSlots:  stats.FreeSlots,
+	}
+}
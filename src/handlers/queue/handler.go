@@ -1,18 +1,28 @@
 package handlers
 
 import (
+	"fmt"
+	"sync"
+
+	"golabs/src/services/history"
 	"golabs/src/services/queue"
 
 	"github.com/gin-gonic/gin"
 )
 
+// QueueHandler holds mu while it mutates or swaps the queue and records the
+// command, and the recorded commands take it again when history runs them.
+// Readers share it, since Initialize swaps the service and undo mutates it.
 type QueueHandler struct {
-	queueService queue.QueueService
+	mu             sync.RWMutex
+	queueService   queue.QueueService
+	historyService history.HistoryService
 }
 
-func NewQueueHandler() *QueueHandler {
+func NewQueueHandler(historyService history.HistoryService) *QueueHandler {
 	return &QueueHandler{
-		queueService: queue.NewQueueService(),
+		queueService:   queue.NewQueueService(),
+		historyService: historyService,
 	}
 }
 
@@ -24,11 +34,36 @@ func (handler *QueueHandler) Initialize(c *gin.Context) {
 		return
 	}
 
-	handler.queueService.Initialize(request.Capacity)
+	// A fresh service rather than re-initializing in place, so undo can hand
+	// the previous queue back untouched.
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	previous := handler.queueService
+	queueService := queue.NewQueueService()
+	if err := queueService.Initialize(request.Capacity, queue.Mode(request.Mode)); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+	handler.queueService = queueService
+
+	handler.historyService.Record(history.Command{
+		Service:     "queue",
+		Description: fmt.Sprintf("initialize with capacity %d", request.Capacity),
+		Undo: handler.locked(func() error {
+			handler.queueService = previous
+			return nil
+		}),
+		Redo: handler.locked(func() error {
+			handler.queueService = queueService
+			return nil
+		}),
+	})
 
 	c.JSON(200, gin.H{
 		"status":   "initialized",
 		"capacity": request.Capacity,
+		"mode":     queueService.Mode(),
 	})
 }
 
@@ -40,24 +75,66 @@ func (handler *QueueHandler) Enqueue(c *gin.Context) {
 		return
 	}
 
-	if err := handler.queueService.Enqueue(request.Value); err != nil {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	evicted, overwritten, err := handler.queueService.Enqueue(request.Value)
+
+	if err != nil {
 		c.JSON(409, gin.H{"error": err.Error()})
 		return
 	}
 
+	// Undoing an overwrite puts the evicted value back at the head.
+	handler.historyService.Record(history.Command{
+		Service:     "queue",
+		Description: fmt.Sprintf("enqueue %q", request.Value),
+		Undo: handler.locked(func() error {
+			if _, err := handler.queueService.RemoveTail(); err != nil {
+				return err
+			}
+			if overwritten {
+				return handler.queueService.RestoreHead(evicted)
+			}
+			return nil
+		}),
+		Redo: handler.locked(func() error {
+			_, _, err := handler.queueService.Enqueue(request.Value)
+			return err
+		}),
+	})
+
 	c.JSON(200, gin.H{
-		"status": "Enqueued Successfully",
-		"value":  request.Value,
+		"status":      "Enqueued Successfully",
+		"value":       request.Value,
+		"evicted":     evicted,
+		"overwritten": overwritten,
 	})
 }
 
 func (handler *QueueHandler) Dequeue(c *gin.Context) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	response, err := handler.queueService.Dequeue()
 
 	if err != nil {
-		c.JSON(200, gin.H{"error": err.Error()})
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
 	}
 
+	handler.historyService.Record(history.Command{
+		Service:     "queue",
+		Description: fmt.Sprintf("dequeue %q", response),
+		Undo: handler.locked(func() error {
+			return handler.queueService.RestoreHead(response)
+		}),
+		Redo: handler.locked(func() error {
+			_, err := handler.queueService.Dequeue()
+			return err
+		}),
+	})
+
 	c.JSON(200, gin.H{
 		"status": "Ok",
 		"value":  response,
@@ -65,6 +142,9 @@ func (handler *QueueHandler) Dequeue(c *gin.Context) {
 }
 
 func (handler *QueueHandler) Tail(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.queueService.Tail()
 
 	if err != nil {
@@ -79,6 +159,9 @@ func (handler *QueueHandler) Tail(c *gin.Context) {
 }
 
 func (handler *QueueHandler) Head(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.queueService.Head()
 
 	if err != nil {
@@ -93,6 +176,9 @@ func (handler *QueueHandler) Head(c *gin.Context) {
 }
 
 func (handler *QueueHandler) Size(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.queueService.Size()
 
 	if err != nil {
@@ -106,6 +192,9 @@ func (handler *QueueHandler) Size(c *gin.Context) {
 }
 
 func (handler *QueueHandler) IsEmpty(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.queueService.IsEmpty()
 
 	if err != nil {
@@ -118,3 +207,80 @@ func (handler *QueueHandler) IsEmpty(c *gin.Context) {
 		"empty":  response,
 	})
 }
+
+func (handler *QueueHandler) Contents(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
+	response, err := handler.queueService.Contents()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+		"size":   len(response),
+	})
+}
+
+func (handler *QueueHandler) PeekAt(c *gin.Context) {
+	var params GetIndex
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
+	response, err := handler.queueService.PeekAt(*params.Index)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"index":  *params.Index,
+		"value":  response,
+	})
+}
+
+func (handler *QueueHandler) Latest(c *gin.Context) {
+	var params GetLatest
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
+	response, err := handler.queueService.Latest(*params.N)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+// locked wraps a history callback so it runs under the handler's lock.
+func (handler *QueueHandler) locked(run func() error) func() error {
+	return func() error {
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+
+		return run()
+	}
+}
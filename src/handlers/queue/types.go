@@ -1,9 +1,18 @@
 package handlers
 
 type InitializeRequest struct {
-	Capacity int `json:"capacity" binding:"required"`
+	Capacity int    `json:"capacity" binding:"required"`
+	Mode     string `json:"mode" binding:"omitempty,oneof=bounded overwrite"`
 }
 
 type EnqueueRequest struct {
 	Value string `json:"value" binding:"required"`
 }
+
+type GetIndex struct {
+	Index *int `form:"index" binding:"required"`
+}
+
+type GetLatest struct {
+	N *int `form:"n" binding:"required,gte=0"`
+}
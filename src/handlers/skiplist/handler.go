@@ -1,93 +1,64 @@
 package handlers
 
 import (
-	"errors"
 	skiplist "golabs/src/services/skiplist"
+	"sync/atomic"
 
 	"github.com/gin-gonic/gin"
 )
 
+type keyedRoutes interface {
+	Seed(c *gin.Context)
+	Stats(c *gin.Context)
+	Delete(c *gin.Context)
+	Insert(c *gin.Context)
+	Search(c *gin.Context)
+	Contains(c *gin.Context)
+	Range(c *gin.Context)
+	Floor(c *gin.Context)
+	Ceiling(c *gin.Context)
+	Next(c *gin.Context)
+	Prev(c *gin.Context)
+	First(c *gin.Context)
+	Last(c *gin.Context)
+	Iterate(c *gin.Context)
+	Rank(c *gin.Context)
+	GetByRank(c *gin.Context)
+	RangeByRank(c *gin.Context)
+	Debug(c *gin.Context)
+}
+
 type SkipListHandler struct {
-	skiplistService skiplist.SkipListService
+	intKeys    *keyedHandler[int]
+	stringKeys *keyedHandler[string]
+	stringMode atomic.Bool
 }
 
 func NewSkiplistHandler() *SkipListHandler {
 	return &SkipListHandler{
-		skiplistService: skiplist.NewSkipList(),
+		intKeys:    newKeyedHandler(skiplist.NewConcurrentSkipList(), parseIntKey),
+		stringKeys: newKeyedHandler(skiplist.NewConcurrentStringSkipList(), parseStringKey),
 	}
 }
 
-func (handler *SkipListHandler) Seed(c *gin.Context) {
-	size := handler.skiplistService.Seed()
-
-	c.JSON(200, gin.H{
-		"status": "seeded",
-		"data": gin.H{
-			"size": size,
-		},
-	})
-}
-
-func (handler *SkipListHandler) Delete(c *gin.Context) {
-	var request GetNodeKey
-
-	if err := c.ShouldBindQuery(&request); err != nil {
-		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
-		return
-	}
-
-	deletedValue, err := handler.skiplistService.Delete(request.Key)
-
-	if errors.Is(err, skiplist.ErrNotFound) {
-		c.JSON(404, gin.H{"error": err.Error()})
-		return
-	}
-
-	if err != nil {
-		c.JSON(409, gin.H{"error": err.Error()})
-		return
-	}
-
-	c.JSON(200, gin.H{
-		"status": "node deleted",
-		"data": gin.H{
-			"deletedValue": deletedValue,
-		},
-	})
-}
-
-func (handler *SkipListHandler) Insert(c *gin.Context) {
-	var request NodeValue
+func (handler *SkipListHandler) Initialize(c *gin.Context) {
+	var request InitializeRequest
 
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
 		return
 	}
 
-	oldValue, replaced := handler.skiplistService.Insert(request.Key, request.Value)
-
-	c.JSON(201, gin.H{
-		"status": "node added",
-		"data": gin.H{
-			"oldValue": oldValue,
-			"replaced": replaced,
-		},
-	})
-}
-
-func (handler *SkipListHandler) Search(c *gin.Context) {
-	var request GetNodeKey
-
-	if err := c.ShouldBindQuery(&request); err != nil {
-		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
-		return
+	if request.KeyType == "" {
+		request.KeyType = KeyTypeInt
 	}
 
-	valueFound, err := handler.skiplistService.Search(request.Key)
-
-	if errors.Is(err, skiplist.ErrNotFound) {
-		c.JSON(404, gin.H{"error": err.Error()})
-		return
+	var stats skiplist.Stats
+	var err error
+	if request.KeyType == KeyTypeString {
+		stats, err = handler.stringKeys.initialize(request)
+	} else {
+		stats, err = handler.intKeys.initialize(request)
 	}
 
 	if err != nil {
@@ -95,33 +66,42 @@ func (handler *SkipListHandler) Search(c *gin.Context) {
 		return
 	}
 
+	handler.stringMode.Store(request.KeyType == KeyTypeString)
+
 	c.JSON(200, gin.H{
-		"status": "node found",
+		"status": "initialized",
 		"data": gin.H{
-			"valueFound": valueFound,
+			"keyType":     request.KeyType,
+			"maxLevel":    stats.MaxLevel,
+			"probability": stats.Probability,
+			"seed":        stats.Seed,
 		},
 	})
 }
 
-func (handler *SkipListHandler) Contains(c *gin.Context) {
-	var request GetNodeKey
-
-	if err := c.ShouldBindQuery(&request); err != nil {
-		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
-		return
-	}
-
-	found, err := handler.skiplistService.Contains(request.Key)
-
-	if err != nil {
-		c.JSON(409, gin.H{"error": err.Error()})
-		return
+func (handler *SkipListHandler) Seed(c *gin.Context)        { handler.active().Seed(c) }
+func (handler *SkipListHandler) Stats(c *gin.Context)       { handler.active().Stats(c) }
+func (handler *SkipListHandler) Delete(c *gin.Context)      { handler.active().Delete(c) }
+func (handler *SkipListHandler) Insert(c *gin.Context)      { handler.active().Insert(c) }
+func (handler *SkipListHandler) Search(c *gin.Context)      { handler.active().Search(c) }
+func (handler *SkipListHandler) Contains(c *gin.Context)    { handler.active().Contains(c) }
+func (handler *SkipListHandler) Range(c *gin.Context)       { handler.active().Range(c) }
+func (handler *SkipListHandler) Floor(c *gin.Context)       { handler.active().Floor(c) }
+func (handler *SkipListHandler) Ceiling(c *gin.Context)     { handler.active().Ceiling(c) }
+func (handler *SkipListHandler) Next(c *gin.Context)        { handler.active().Next(c) }
+func (handler *SkipListHandler) Prev(c *gin.Context)        { handler.active().Prev(c) }
+func (handler *SkipListHandler) First(c *gin.Context)       { handler.active().First(c) }
+func (handler *SkipListHandler) Last(c *gin.Context)        { handler.active().Last(c) }
+func (handler *SkipListHandler) Iterate(c *gin.Context)     { handler.active().Iterate(c) }
+func (handler *SkipListHandler) Rank(c *gin.Context)        { handler.active().Rank(c) }
+func (handler *SkipListHandler) GetByRank(c *gin.Context)   { handler.active().GetByRank(c) }
+func (handler *SkipListHandler) RangeByRank(c *gin.Context) { handler.active().RangeByRank(c) }
+func (handler *SkipListHandler) Debug(c *gin.Context)       { handler.active().Debug(c) }
+
+func (handler *SkipListHandler) active() keyedRoutes {
+	if handler.stringMode.Load() {
+		return handler.stringKeys
 	}
 
-	c.JSON(200, gin.H{
-		"status": "node found",
-		"data": gin.H{
-			"found": found,
-		},
-	})
+	return handler.intKeys
 }
@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"errors"
+	skiplist "golabs/src/services/skiplist"
+
+	"github.com/gin-gonic/gin"
+)
+
+// keyedHandler serves the skip list routes for one key type. SkipListHandler
+// keeps one per supported key type and forwards to the active one.
+type keyedHandler[K any] struct {
+	skiplistService skiplist.KeyedSkipListService[K]
+	parseKey        func(raw string) (K, error)
+}
+
+func newKeyedHandler[K any](service skiplist.KeyedSkipListService[K], parseKey func(raw string) (K, error)) *keyedHandler[K] {
+	return &keyedHandler[K]{
+		skiplistService: service,
+		parseKey:        parseKey,
+	}
+}
+
+func (handler *keyedHandler[K]) initialize(request InitializeRequest) (skiplist.Stats, error) {
+	if err := handler.skiplistService.Initialize(request.MaxLevel, request.Probability, request.Seed); err != nil {
+		return skiplist.Stats{}, err
+	}
+
+	return handler.skiplistService.Stats(0), nil
+}
+
+func (handler *keyedHandler[K]) Seed(c *gin.Context) {
+	size := handler.skiplistService.Seed()
+
+	c.JSON(200, gin.H{
+		"status": "seeded",
+		"data": gin.H{
+			"size": size,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Stats(c *gin.Context) {
+	var request GetStats
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	stats := handler.skiplistService.Stats(request.Sample)
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"data":   ToStatsView(stats),
+	})
+}
+
+func (handler *keyedHandler[K]) Delete(c *gin.Context) {
+	key, ok := handler.bindKey(c)
+	if !ok {
+		return
+	}
+
+	deletedValue, err := handler.skiplistService.Delete(key)
+
+	if errors.Is(err, skiplist.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node deleted",
+		"data": gin.H{
+			"deletedValue": deletedValue,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Insert(c *gin.Context) {
+	var request NodeValue
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	key, err := handler.parseKey(rawKey(request.Key))
+	if err != nil {
+		c.JSON(400, gin.H{"error": "Invalid key", "details": err.Error()})
+		return
+	}
+
+	oldValue, replaced := handler.skiplistService.Insert(key, request.Value)
+
+	c.JSON(201, gin.H{
+		"status": "node added",
+		"data": gin.H{
+			"oldValue": oldValue,
+			"replaced": replaced,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Search(c *gin.Context) {
+	key, ok := handler.bindKey(c)
+	if !ok {
+		return
+	}
+
+	valueFound, err := handler.skiplistService.Search(key)
+
+	if errors.Is(err, skiplist.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"data": gin.H{
+			"valueFound": valueFound,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Contains(c *gin.Context) {
+	key, ok := handler.bindKey(c)
+	if !ok {
+		return
+	}
+
+	found, err := handler.skiplistService.Contains(key)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"data": gin.H{
+			"found": found,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Range(c *gin.Context) {
+	var request GetRange
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	lo, err := handler.parseKey(request.Lo)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "Invalid key", "details": err.Error()})
+		return
+	}
+
+	hi, err := handler.parseKey(request.Hi)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "Invalid key", "details": err.Error()})
+		return
+	}
+
+	entries, err := handler.skiplistService.Range(lo, hi, request.Limit)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "range found",
+		"data": gin.H{
+			"entries": ToEntryViews(entries),
+			"count":   len(entries),
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Floor(c *gin.Context) {
+	handler.respondWithEntry(c, handler.skiplistService.Floor)
+}
+
+func (handler *keyedHandler[K]) Ceiling(c *gin.Context) {
+	handler.respondWithEntry(c, handler.skiplistService.Ceiling)
+}
+
+func (handler *keyedHandler[K]) Next(c *gin.Context) {
+	handler.respondWithEntry(c, handler.skiplistService.Next)
+}
+
+func (handler *keyedHandler[K]) Prev(c *gin.Context) {
+	handler.respondWithEntry(c, handler.skiplistService.Prev)
+}
+
+func (handler *keyedHandler[K]) First(c *gin.Context) {
+	entry, err := handler.skiplistService.First()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"data":   ToEntryView(entry),
+	})
+}
+
+func (handler *keyedHandler[K]) Last(c *gin.Context) {
+	entry, err := handler.skiplistService.Last()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"data":   ToEntryView(entry),
+	})
+}
+
+func (handler *keyedHandler[K]) Iterate(c *gin.Context) {
+	var request GetIterate
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	from, err := handler.parseKey(request.From)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "Invalid key", "details": err.Error()})
+		return
+	}
+
+	if request.Limit == 0 {
+		request.Limit = 10
+	}
+
+	reverse := request.Direction == "backward"
+	entries, err := handler.skiplistService.Iterate(from, request.Limit, reverse)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "iteration completed",
+		"data": gin.H{
+			"entries": ToEntryViews(entries),
+			"count":   len(entries),
+			"reverse": reverse,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Rank(c *gin.Context) {
+	key, ok := handler.bindKey(c)
+	if !ok {
+		return
+	}
+
+	rank, err := handler.skiplistService.Rank(key)
+
+	if errors.Is(err, skiplist.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "rank found",
+		"data": gin.H{
+			"rank": rank,
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) GetByRank(c *gin.Context) {
+	var request GetNodeRank
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	entry, err := handler.skiplistService.GetByRank(*request.Rank)
+
+	if errors.Is(err, skiplist.ErrRankNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"data":   ToEntryView(entry),
+	})
+}
+
+func (handler *keyedHandler[K]) RangeByRank(c *gin.Context) {
+	var request GetRankRange
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	entries, err := handler.skiplistService.RangeByRank(*request.Start, *request.Stop)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "range found",
+		"data": gin.H{
+			"entries": ToEntryViews(entries),
+			"count":   len(entries),
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) Debug(c *gin.Context) {
+	snapshot := handler.skiplistService.Debug()
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"data": gin.H{
+			"diagram": snapshot.Diagram,
+			"levels":  ToLevelViews(snapshot.Levels),
+		},
+	})
+}
+
+func (handler *keyedHandler[K]) respondWithEntry(c *gin.Context, lookup func(key K) (skiplist.Entry[K, string], error)) {
+	key, ok := handler.bindKey(c)
+	if !ok {
+		return
+	}
+
+	entry, err := lookup(key)
+
+	if errors.Is(err, skiplist.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "node found",
+		"data":   ToEntryView(entry),
+	})
+}
+
+func (handler *keyedHandler[K]) bindKey(c *gin.Context) (K, bool) {
+	var request GetNodeKey
+	var key K
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return key, false
+	}
+
+	key, err := handler.parseKey(request.Key)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "Invalid key", "details": err.Error()})
+		return key, false
+	}
+
+	return key, true
+}
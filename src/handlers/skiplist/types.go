@@ -1,10 +1,152 @@
 package handlers
 
+import (
+	"encoding/json"
+	"strconv"
+
+	service "golabs/src/services/skiplist"
+)
+
+const (
+	KeyTypeInt    = "int"
+	KeyTypeString = "string"
+)
+
+// Keys arrive as text in query strings and as raw JSON in bodies; the active
+// key type decides how they are parsed.
 type NodeValue struct {
-	Key   int    `json:"key" binding:"required"`
-	Value string `json:"value" binding:"required"`
+	Key   json.RawMessage `json:"key" binding:"required"`
+	Value string          `json:"value" binding:"required"`
 }
 
 type GetNodeKey struct {
-	Key int `form:"key" binding:"required"`
+	Key string `form:"key" binding:"required"`
+}
+
+type GetRange struct {
+	Lo    string `form:"lo" binding:"required"`
+	Hi    string `form:"hi" binding:"required"`
+	Limit int    `form:"limit" binding:"gte=0"`
+}
+
+type GetIterate struct {
+	From      string `form:"from" binding:"required"`
+	Limit     int    `form:"limit" binding:"gte=0"`
+	Direction string `form:"direction" binding:"omitempty,oneof=forward backward"`
+}
+
+type EntryView struct {
+	Key   any    `json:"key"`
+	Value string `json:"value"`
+}
+
+func ToEntryView[K any](entry service.Entry[K, string]) EntryView {
+	return EntryView{
+		Key:   entry.Key,
+		Value: entry.Value,
+	}
+}
+
+func ToEntryViews[K any](entries []service.Entry[K, string]) []EntryView {
+	views := make([]EntryView, 0, len(entries))
+	for _, entry := range entries {
+		views = append(views, ToEntryView(entry))
+	}
+	return views
+}
+
+type GetNodeRank struct {
+	Rank *int `form:"rank" binding:"required,gte=0"`
+}
+
+type GetRankRange struct {
+	Start *int `form:"start" binding:"required"`
+	Stop  *int `form:"stop" binding:"required"`
+}
+
+type InitializeRequest struct {
+	KeyType     string  `json:"keyType" binding:"omitempty,oneof=int string"`
+	MaxLevel    int     `json:"maxLevel" binding:"omitempty,gte=1,lte=32"`
+	Probability float64 `json:"probability" binding:"omitempty,gt=0,lt=1"`
+	Seed        int64   `json:"seed"`
+}
+
+type GetStats struct {
+	Sample int `form:"sample" binding:"gte=0"`
+}
+
+type StatsView struct {
+	Size              int     `json:"size"`
+	Level             int     `json:"level"`
+	MaxLevel          int     `json:"maxLevel"`
+	Probability       float64 `json:"probability"`
+	Seed              int64   `json:"seed"`
+	NodesPerLevel     []int   `json:"nodesPerLevel"`
+	SampledKeys       int     `json:"sampledKeys"`
+	AverageSearchHops float64 `json:"averageSearchHops"`
+}
+
+func ToStatsView(stats service.Stats) StatsView {
+	return StatsView{
+		Size:              stats.Size,
+		Level:             stats.Level,
+		MaxLevel:          stats.MaxLevel,
+		Probability:       stats.Probability,
+		Seed:              stats.Seed,
+		NodesPerLevel:     stats.NodesPerLevel,
+		SampledKeys:       stats.SampledKeys,
+		AverageSearchHops: stats.AverageSearchHops,
+	}
+}
+
+type LevelNodeView struct {
+	Key  any `json:"key"`
+	Span int `json:"span"`
+	Next any `json:"next"`
+}
+
+type LevelView struct {
+	Level int             `json:"level"`
+	Nodes []LevelNodeView `json:"nodes"`
+}
+
+func ToLevelViews[K any](levels []service.Level[K]) []LevelView {
+	views := make([]LevelView, 0, len(levels))
+	for _, level := range levels {
+		nodes := make([]LevelNodeView, 0, len(level.Nodes))
+		for _, node := range level.Nodes {
+			var next any
+			if node.Next != nil {
+				next = *node.Next
+			}
+
+			nodes = append(nodes, LevelNodeView{
+				Key:  node.Key,
+				Span: node.Span,
+				Next: next,
+			})
+		}
+
+		views = append(views, LevelView{Level: level.Level, Nodes: nodes})
+	}
+	return views
+}
+
+func parseIntKey(raw string) (int, error) {
+	return strconv.Atoi(raw)
+}
+
+func parseStringKey(raw string) (string, error) {
+	return raw, nil
+}
+
+// rawKey turns a JSON key into the text form used by query strings, so "5"
+// and 5 are read the same way.
+func rawKey(raw json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+
+	return string(raw)
 }
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"errors"
+	sortedset "golabs/src/services/sortedset"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SortedSetHandler struct {
+	sortedSetService sortedset.SortedSetService
+}
+
+func NewSortedSetHandler() *SortedSetHandler {
+	return &SortedSetHandler{
+		sortedSetService: sortedset.NewSortedSet(),
+	}
+}
+
+func (handler *SortedSetHandler) ZAdd(c *gin.Context) {
+	var request MemberScore
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	added := handler.sortedSetService.ZAdd(request.Member, *request.Score)
+
+	c.JSON(201, gin.H{
+		"status": "member added",
+		"data": gin.H{
+			"added": added,
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZIncrBy(c *gin.Context) {
+	var request MemberIncrement
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	newScore, err := handler.sortedSetService.ZIncrBy(request.Member, *request.Increment)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "member incremented",
+		"data": gin.H{
+			"score": newScore,
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZScore(c *gin.Context) {
+	var request GetMember
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	score, err := handler.sortedSetService.ZScore(request.Member)
+
+	if errors.Is(err, sortedset.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "member found",
+		"data": gin.H{
+			"score": score,
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZRank(c *gin.Context) {
+	var request GetMember
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	rank, err := handler.sortedSetService.ZRank(request.Member)
+
+	if errors.Is(err, sortedset.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "rank found",
+		"data": gin.H{
+			"rank": rank,
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZRange(c *gin.Context) {
+	var request GetRankRange
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	members, err := handler.sortedSetService.ZRange(*request.Start, *request.Stop)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "range found",
+		"data": gin.H{
+			"members": ToMemberViews(members),
+			"count":   len(members),
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZRangeByScore(c *gin.Context) {
+	var request GetScoreRange
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	members, err := handler.sortedSetService.ZRangeByScore(*request.Min, *request.Max, request.Limit)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "range found",
+		"data": gin.H{
+			"members": ToMemberViews(members),
+			"count":   len(members),
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZCard(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"data": gin.H{
+			"size": handler.sortedSetService.ZCard(),
+		},
+	})
+}
+
+func (handler *SortedSetHandler) ZRem(c *gin.Context) {
+	var request GetMember
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	score, err := handler.sortedSetService.ZRem(request.Member)
+
+	if errors.Is(err, sortedset.ErrNotFound) {
+		c.JSON(404, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "member removed",
+		"data": gin.H{
+			"score": score,
+		},
+	})
+}
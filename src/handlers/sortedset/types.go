@@ -0,0 +1,41 @@
+package handlers
+
+import service "golabs/src/services/sortedset"
+
+type MemberScore struct {
+	Member string   `json:"member" binding:"required"`
+	Score  *float64 `json:"score" binding:"required"`
+}
+
+type MemberIncrement struct {
+	Member    string   `json:"member" binding:"required"`
+	Increment *float64 `json:"increment" binding:"required"`
+}
+
+type GetMember struct {
+	Member string `form:"member" binding:"required"`
+}
+
+type GetRankRange struct {
+	Start *int `form:"start" binding:"required"`
+	Stop  *int `form:"stop" binding:"required"`
+}
+
+type GetScoreRange struct {
+	Min   *float64 `form:"min" binding:"required"`
+	Max   *float64 `form:"max" binding:"required"`
+	Limit int      `form:"limit" binding:"gte=0"`
+}
+
+type MemberView struct {
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+func ToMemberViews(members []service.Member) []MemberView {
+	views := make([]MemberView, 0, len(members))
+	for _, member := range members {
+		views = append(views, MemberView{Member: member.Member, Score: member.Score})
+	}
+	return views
+}
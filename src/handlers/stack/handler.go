@@ -1,18 +1,35 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"sync"
+
 	"github.com/gin-gonic/gin"
 
+	"golabs/src/services/brackets"
+	"golabs/src/services/expression"
+	"golabs/src/services/history"
 	"golabs/src/services/stack"
 )
 
+// StackHandler holds mu while it mutates the stack and records the command,
+// and the recorded commands take it again when history runs them. Readers
+// share it, since Initialize swaps the service and undo mutates it.
 type StackHandler struct {
-	stackService stack.StackService
+	mu                sync.RWMutex
+	stackService      stack.MinMaxStackService
+	expressionService expression.ExpressionService
+	bracketService    brackets.BracketService
+	historyService    history.HistoryService
 }
 
-func NewStackHandler() *StackHandler {
+func NewStackHandler(historyService history.HistoryService) *StackHandler {
 	return &StackHandler{
-		stackService: stack.NewStackService(),
+		historyService:    historyService,
+		stackService:      stack.NewMinMaxStackService(stack.OrderLexical),
+		expressionService: expression.NewExpressionService(),
+		bracketService:    brackets.NewBracketService(),
 	}
 }
 
@@ -24,11 +41,42 @@ func (handler *StackHandler) Initialize(c *gin.Context) {
 		return
 	}
 
-	handler.stackService.Initialize(request.Capacity)
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	stackService := stack.NewMinMaxStackService(stack.Order(request.Order))
+	if err := stackService.Initialize(request.Capacity, request.ToPolicy()); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	previous := handler.stackService
+	handler.stackService = stackService
+
+	handler.historyService.Record(history.Command{
+		Service:     "stack",
+		Description: fmt.Sprintf("initialize with capacity %d", request.Capacity),
+		Undo: handler.locked(func() error {
+			handler.stackService = previous
+			return nil
+		}),
+		Redo: handler.locked(func() error {
+			handler.stackService = stackService
+			return nil
+		}),
+	})
+
+	stats, _ := handler.stackService.Stats()
 
 	c.JSON(200, gin.H{
 		"status":   "initialized",
 		"capacity": request.Capacity,
+		"order":    handler.stackService.Order(),
+		"policy": gin.H{
+			"mode":   stats.Policy.Mode,
+			"growth": stats.Policy.Growth,
+			"shrink": stats.Policy.Shrink,
+		},
 	})
 
 }
@@ -41,6 +89,9 @@ func (handler *StackHandler) Push(c *gin.Context) {
 		return
 	}
 
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	err := handler.stackService.Push(request.Value)
 
 	if err != nil {
@@ -48,6 +99,18 @@ func (handler *StackHandler) Push(c *gin.Context) {
 		return
 	}
 
+	handler.historyService.Record(history.Command{
+		Service:     "stack",
+		Description: fmt.Sprintf("push %q", request.Value),
+		Undo: handler.locked(func() error {
+			_, err := handler.stackService.Pop()
+			return err
+		}),
+		Redo: handler.locked(func() error {
+			return handler.stackService.Push(request.Value)
+		}),
+	})
+
 	c.JSON(200, gin.H{
 		"status": "Ok",
 		"value":  request.Value,
@@ -55,6 +118,9 @@ func (handler *StackHandler) Push(c *gin.Context) {
 }
 
 func (handler *StackHandler) Pop(c *gin.Context) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
 	response, err := handler.stackService.Pop()
 
 	if err != nil {
@@ -62,6 +128,18 @@ func (handler *StackHandler) Pop(c *gin.Context) {
 		return
 	}
 
+	handler.historyService.Record(history.Command{
+		Service:     "stack",
+		Description: fmt.Sprintf("pop %q", response),
+		Undo: handler.locked(func() error {
+			return handler.stackService.Push(response)
+		}),
+		Redo: handler.locked(func() error {
+			_, err := handler.stackService.Pop()
+			return err
+		}),
+	})
+
 	c.JSON(200, gin.H{
 		"status": "Ok",
 		"value":  response,
@@ -69,6 +147,9 @@ func (handler *StackHandler) Pop(c *gin.Context) {
 }
 
 func (handler *StackHandler) Peek(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.stackService.Peek()
 
 	if err != nil {
@@ -83,6 +164,9 @@ func (handler *StackHandler) Peek(c *gin.Context) {
 }
 
 func (handler *StackHandler) Size(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.stackService.Size()
 
 	if err != nil {
@@ -96,6 +180,9 @@ func (handler *StackHandler) Size(c *gin.Context) {
 }
 
 func (handler *StackHandler) IsEmpty(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
 	response, err := handler.stackService.IsEmpty()
 
 	if err != nil {
@@ -108,3 +195,118 @@ func (handler *StackHandler) IsEmpty(c *gin.Context) {
 		"empty":  response,
 	})
 }
+
+func (handler *StackHandler) Stats(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
+	response, err := handler.stackService.Stats()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stats":  ToStatsView(response),
+	})
+}
+
+func (handler *StackHandler) Min(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
+	response, err := handler.stackService.Min()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *StackHandler) Max(c *gin.Context) {
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+
+	response, err := handler.stackService.Max()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *StackHandler) Evaluate(c *gin.Context) {
+	var request EvaluateRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	response, err := handler.expressionService.Evaluate(request.Expression)
+
+	var expressionErr *expression.Error
+	if errors.As(err, &expressionErr) {
+		c.JSON(409, gin.H{"error": expressionErr.Message, "position": expressionErr.Position})
+		return
+	}
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":  "Ok",
+		"value":   response.Value,
+		"tokens":  response.Tokens,
+		"postfix": response.Postfix,
+		"trace":   ToTraceView(response.Trace),
+	})
+}
+
+func (handler *StackHandler) CheckBrackets(c *gin.Context) {
+	var request CheckBracketsRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	response, err := handler.bracketService.Check(request.Text, brackets.Syntax(request.Syntax))
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":   "Ok",
+		"balanced": response.Balanced,
+		"mismatch": ToMismatchView(response.Mismatch),
+		"unclosed": ToUnclosedView(response.Unclosed),
+		"maxDepth": response.MaxDepth,
+	})
+}
+
+// locked wraps a history callback so it runs under the handler's lock.
+func (handler *StackHandler) locked(run func() error) func() error {
+	return func() error {
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+
+		return run()
+	}
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"golabs/src/services/stack"
+)
+
+type MultiStackHandler struct {
+	multiStackService stack.MultiStackService
+}
+
+func NewMultiStackHandler() *MultiStackHandler {
+	return &MultiStackHandler{
+		multiStackService: stack.NewMultiStackService(),
+	}
+}
+
+func (handler *MultiStackHandler) Initialize(c *gin.Context) {
+	var request MultiStackInitializeRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.multiStackService.Initialize(request.Stacks, request.Capacity); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":   "initialized",
+		"stacks":   request.Stacks,
+		"capacity": request.Capacity,
+	})
+}
+
+func (handler *MultiStackHandler) Push(c *gin.Context) {
+	var request MultiStackPushRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.multiStackService.Push(*request.Stack, request.Value); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stack":  *request.Stack,
+		"value":  request.Value,
+	})
+}
+
+func (handler *MultiStackHandler) Pop(c *gin.Context) {
+	var params GetMultiStack
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	response, err := handler.multiStackService.Pop(*params.Stack)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stack":  *params.Stack,
+		"value":  response,
+	})
+}
+
+func (handler *MultiStackHandler) Peek(c *gin.Context) {
+	var params GetMultiStack
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	response, err := handler.multiStackService.Peek(*params.Stack)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stack":  *params.Stack,
+		"value":  response,
+	})
+}
+
+func (handler *MultiStackHandler) Size(c *gin.Context) {
+	var params GetMultiStack
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid Query format", "details": err.Error()})
+		return
+	}
+
+	response, err := handler.multiStackService.Size(*params.Stack)
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stack":  *params.Stack,
+		"size":   response,
+	})
+}
+
+func (handler *MultiStackHandler) Stats(c *gin.Context) {
+	response, err := handler.multiStackService.Stats()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stats":  ToMultiStackStatsView(response),
+	})
+}
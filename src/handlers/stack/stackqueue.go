@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"golabs/src/services/stack"
+)
+
+type StackQueueHandler struct {
+	stackQueueService stack.StackQueueService
+}
+
+func NewStackQueueHandler() *StackQueueHandler {
+	return &StackQueueHandler{
+		stackQueueService: stack.NewStackQueueService(),
+	}
+}
+
+func (handler *StackQueueHandler) Enqueue(c *gin.Context) {
+	var request PushRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid JSON format", "details": err.Error()})
+		return
+	}
+
+	if err := handler.stackQueueService.Enqueue(request.Value); err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Enqueued Successfully",
+		"value":  request.Value,
+	})
+}
+
+func (handler *StackQueueHandler) Dequeue(c *gin.Context) {
+	response, err := handler.stackQueueService.Dequeue()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *StackQueueHandler) Front(c *gin.Context) {
+	response, err := handler.stackQueueService.Front()
+
+	if err != nil {
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"value":  response,
+	})
+}
+
+func (handler *StackQueueHandler) Size(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"size":   handler.stackQueueService.Size(),
+	})
+}
+
+func (handler *StackQueueHandler) IsEmpty(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"empty":  handler.stackQueueService.IsEmpty(),
+	})
+}
+
+func (handler *StackQueueHandler) Clear(c *gin.Context) {
+	handler.stackQueueService.Clear()
+
+	c.JSON(200, gin.H{"status": "queue cleared"})
+}
+
+func (handler *StackQueueHandler) Stats(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "Ok",
+		"stats":  ToStackQueueStatsView(handler.stackQueueService.Stats()),
+	})
+}
@@ -1,9 +1,201 @@
 package handlers
 
+import (
+	"golabs/src/services/brackets"
+	"golabs/src/services/expression"
+	"golabs/src/services/stack"
+)
+
 type InitializeRequest struct {
-	Capacity int `json:"capacity" binding:"required"`
+	Capacity int    `json:"capacity" binding:"required"`
+	Mode     string `json:"mode" binding:"omitempty,oneof=bounded growable"`
+	Growth   string `json:"growth" binding:"omitempty,oneof=2x 1.5x"`
+	Shrink   bool   `json:"shrink"`
+	Order    string `json:"order" binding:"omitempty,oneof=lexical numeric"`
+}
+
+func (request InitializeRequest) ToPolicy() stack.Policy {
+	return stack.Policy{
+		Mode:   stack.Mode(request.Mode),
+		Growth: stack.Growth(request.Growth),
+		Shrink: request.Shrink,
+	}
 }
 
 type PushRequest struct {
 	Value string `json:"value" binding:"required"`
 }
+
+type StatsView struct {
+	Mode          string  `json:"mode"`
+	Growth        string  `json:"growth,omitempty"`
+	Shrink        bool    `json:"shrink"`
+	Capacity      int     `json:"capacity"`
+	Size          int     `json:"size"`
+	Resizes       int     `json:"resizes"`
+	Grows         int     `json:"grows"`
+	Shrinks       int     `json:"shrinks"`
+	Copies        int     `json:"copies"`
+	Operations    int     `json:"operations"`
+	AmortizedCost float64 `json:"amortizedCost"`
+}
+
+func ToStatsView(stats stack.Stats) StatsView {
+	return StatsView{
+		Mode:          string(stats.Policy.Mode),
+		Growth:        string(stats.Policy.Growth),
+		Shrink:        stats.Policy.Shrink,
+		Capacity:      stats.Capacity,
+		Size:          stats.Size,
+		Resizes:       stats.Grows + stats.Shrinks,
+		Grows:         stats.Grows,
+		Shrinks:       stats.Shrinks,
+		Copies:        stats.Copies,
+		Operations:    stats.Operations,
+		AmortizedCost: stats.AmortizedCost,
+	}
+}
+
+type EvaluateRequest struct {
+	Expression string `json:"expression" binding:"required"`
+}
+
+type StepView struct {
+	Phase     string   `json:"phase"`
+	Token     string   `json:"token,omitempty"`
+	Action    string   `json:"action"`
+	Operators []string `json:"operators,omitempty"`
+	Output    []string `json:"output,omitempty"`
+	Operands  []string `json:"operands,omitempty"`
+}
+
+func ToTraceView(trace []expression.Step) []StepView {
+	views := make([]StepView, len(trace))
+	for i, step := range trace {
+		views[i] = StepView{
+			Phase:     step.Phase,
+			Token:     step.Token,
+			Action:    step.Action,
+			Operators: step.Operators,
+			Output:    step.Output,
+			Operands:  step.Operands,
+		}
+	}
+	return views
+}
+
+type CheckBracketsRequest struct {
+	Text   string `json:"text" binding:"required"`
+	Syntax string `json:"syntax" binding:"omitempty,oneof=text code"`
+}
+
+type DelimiterView struct {
+	Value  string `json:"value"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+type MismatchView struct {
+	Found    string         `json:"found"`
+	Expected string         `json:"expected,omitempty"`
+	Line     int            `json:"line"`
+	Column   int            `json:"column"`
+	Opening  *DelimiterView `json:"opening,omitempty"`
+	Message  string         `json:"message"`
+}
+
+func ToDelimiterView(delimiter brackets.Delimiter) DelimiterView {
+	return DelimiterView{
+		Value:  delimiter.Value,
+		Line:   delimiter.Line,
+		Column: delimiter.Column,
+	}
+}
+
+func ToMismatchView(mismatch *brackets.Mismatch) *MismatchView {
+	if mismatch == nil {
+		return nil
+	}
+	view := &MismatchView{
+		Found:    mismatch.Found,
+		Expected: mismatch.Expected,
+		Line:     mismatch.Line,
+		Column:   mismatch.Column,
+		Message:  mismatch.Message,
+	}
+	if mismatch.Opening != nil {
+		opening := ToDelimiterView(*mismatch.Opening)
+		view.Opening = &opening
+	}
+	return view
+}
+
+func ToUnclosedView(delimiters []brackets.Delimiter) []DelimiterView {
+	views := make([]DelimiterView, len(delimiters))
+	for i, delimiter := range delimiters {
+		views[i] = ToDelimiterView(delimiter)
+	}
+	return views
+}
+
+type MultiStackInitializeRequest struct {
+	Stacks   int `json:"stacks" binding:"required,gte=1"`
+	Capacity int `json:"capacity" binding:"required,gte=1"`
+}
+
+type MultiStackPushRequest struct {
+	Stack *int   `json:"stack" binding:"required"`
+	Value string `json:"value" binding:"required"`
+}
+
+type GetMultiStack struct {
+	Stack *int `form:"stack" binding:"required"`
+}
+
+type MultiStackStatsView struct {
+	Stacks        int     `json:"stacks"`
+	Capacity      int     `json:"capacity"`
+	Used          int     `json:"used"`
+	Free          int     `json:"free"`
+	Sizes         []int   `json:"sizes"`
+	Writes        int     `json:"writes"`
+	Operations    int     `json:"operations"`
+	AmortizedCost float64 `json:"amortizedCost"`
+}
+
+func ToMultiStackStatsView(stats stack.MultiStackStats) MultiStackStatsView {
+	return MultiStackStatsView{
+		Stacks:        stats.Stacks,
+		Capacity:      stats.Capacity,
+		Used:          stats.Used,
+		Free:          stats.Free,
+		Sizes:         stats.Sizes,
+		Writes:        stats.Writes,
+		Operations:    stats.Operations,
+		AmortizedCost: stats.AmortizedCost,
+	}
+}
+
+type StackQueueStatsView struct {
+	Size            int     `json:"size"`
+	Inbox           int     `json:"inbox"`
+	Outbox          int     `json:"outbox"`
+	Transfers       int     `json:"transfers"`
+	Moves           int     `json:"moves"`
+	StackOperations int     `json:"stackOperations"`
+	Operations      int     `json:"operations"`
+	AmortizedCost   float64 `json:"amortizedCost"`
+}
+
+func ToStackQueueStatsView(stats stack.StackQueueStats) StackQueueStatsView {
+	return StackQueueStatsView{
+		Size:            stats.Size,
+		Inbox:           stats.Inbox,
+		Outbox:          stats.Outbox,
+		Transfers:       stats.Transfers,
+		Moves:           stats.Moves,
+		StackOperations: stats.StackOperations,
+		Operations:      stats.Operations,
+		AmortizedCost:   stats.AmortizedCost,
+	}
+}
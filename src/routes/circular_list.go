@@ -0,0 +1,30 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/linkedlist/circular"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterCircularListRoutes(r *gin.Engine) {
+
+	g := r.Group("/circular-list")
+	{
+		registerCircularVariant(g.Group("/single"), handlers.NewCircularSingleLinkedListHandler())
+		registerCircularVariant(g.Group("/double"), handlers.NewCircularDoubleLinkedListHandler())
+	}
+}
+
+func registerCircularVariant(g *gin.RouterGroup, h *handlers.CircularLinkedListHandler) {
+	g.POST("/add-first", h.AddFirst)
+	g.POST("/add-last", h.AddLast)
+	g.GET("/clear", h.Clear)
+	g.DELETE("/remove", h.Remove)
+	g.DELETE("/remove-first", h.RemoveFirst)
+	g.DELETE("/remove-last", h.RemoveLast)
+	g.POST("/rotate", h.Rotate)
+	g.POST("/josephus", h.Josephus)
+	g.GET("/walk", h.Walk)
+	g.GET("/list", h.List)
+	g.GET("/size", h.Size)
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/deque"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterDequeRoutes(r *gin.Engine) {
+
+	h := handlers.NewDequeHandler()
+
+	g := r.Group("/deque")
+	{
+		g.POST("/initialize", h.Initialize)
+		g.POST("/push-front", h.PushFront)
+		g.POST("/push-back", h.PushBack)
+		g.GET("/pop-front", h.PopFront)
+		g.GET("/pop-back", h.PopBack)
+		g.GET("/peek-front", h.PeekFront)
+		g.GET("/peek-back", h.PeekBack)
+		g.GET("/get", h.Get)
+		g.GET("/list", h.List)
+		g.GET("/size", h.Size)
+		g.GET("/is-empty", h.IsEmpty)
+	}
+}
@@ -2,13 +2,14 @@ package routes
 
 import (
 	handlers "golabs/src/handlers/linkedlist/double"
+	"golabs/src/services/history"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterDoubleLinkedListRoutes(r *gin.Engine) {
+func RegisterDoubleLinkedListRoutes(r *gin.Engine, historyService history.HistoryService) {
 
-	h := handlers.NewDoubleLinkedListHandler()
+	h := handlers.NewDoubleLinkedListHandler(historyService)
 
 	g := r.Group("/double-linked-list")
 	{
@@ -18,11 +19,29 @@ func RegisterDoubleLinkedListRoutes(r *gin.Engine) {
 		g.POST("/find", h.Find)
 		g.GET("/get-at", h.GetAt)
 		g.POST("/index-of", h.IndexOf)
+		g.POST("/find-last", h.FindLast)
+		g.POST("/last-index-of", h.LastIndexOf)
 		g.POST("/insert-after", h.InsertAfter)
 		g.POST("/insert-at", h.InsertAt)
 		g.DELETE("/remove", h.Remove)
 		g.DELETE("/remove-at", h.RemoveAt)
 		g.DELETE("/remove-first", h.RemoveFirst)
 		g.DELETE("/remove-last", h.RemoveLast)
+		g.GET("/size", h.Size)
+		g.GET("/list", h.List)
+		g.GET("/view", h.View)
+		g.GET("/lists", h.Lists)
+		g.POST("/concat", h.Concat)
+		g.POST("/split-at", h.SplitAt)
+		g.POST("/splice", h.Splice)
+		g.GET("/sublist", h.Sublist)
+		g.POST("/cursor/open", h.OpenCursor)
+		g.GET("/cursor", h.GetCursor)
+		g.POST("/cursor/next", h.CursorNext)
+		g.POST("/cursor/prev", h.CursorPrev)
+		g.POST("/cursor/insert-before", h.CursorInsertBefore)
+		g.POST("/cursor/insert-after", h.CursorInsertAfter)
+		g.DELETE("/cursor/remove", h.CursorRemove)
+		g.DELETE("/cursor/close", h.CloseCursor)
 	}
 }
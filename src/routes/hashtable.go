@@ -2,13 +2,14 @@ package routes
 
 import (
 	handlers "golabs/src/handlers/hashtable"
+	"golabs/src/services/history"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterHashTableRoutes(r *gin.Engine) {
+func RegisterHashTableRoutes(r *gin.Engine, historyService history.HistoryService) {
 
-	h := handlers.NewHashTableHandler()
+	h := handlers.NewHashTableHandler(historyService)
 
 	g := r.Group("/hashtable")
 	{
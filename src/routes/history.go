@@ -0,0 +1,21 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/history"
+	"golabs/src/services/history"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterHistoryRoutes(r *gin.Engine, historyService history.HistoryService) {
+
+	h := handlers.NewHistoryHandler(historyService)
+
+	g := r.Group("/history")
+	{
+		g.POST("/undo", h.Undo)
+		g.POST("/redo", h.Redo)
+		g.GET("/list", h.List)
+		g.DELETE("/clear", h.Clear)
+	}
+}
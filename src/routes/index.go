@@ -1,15 +1,27 @@
 package routes
 
 import (
+	"golabs/src/services/history"
+
 	"github.com/gin-gonic/gin"
 )
 
 func RegisterRoutes(r *gin.Engine) {
-	RegisterStackRoutes(r)
-	RegisterQueueRoutes(r)
+	historyService := history.NewHistoryService()
+
+	RegisterStackRoutes(r, historyService)
+	RegisterMultiStackRoutes(r)
+	RegisterStackQueueRoutes(r)
+	RegisterQueueRoutes(r, historyService)
+	RegisterDequeRoutes(r)
 	RegisterSingleLinkedListRoutes(r)
-	RegisterDoubleLinkedListRoutes(r)
+	RegisterDoubleLinkedListRoutes(r, historyService)
+	RegisterCircularListRoutes(r)
+	RegisterUnrolledListRoutes(r)
+	RegisterXorListRoutes(r)
 	RegisterSkipListRoutes(r)
-	RegisterHashTableRoutes(r)
+	RegisterSortedSetRoutes(r)
+	RegisterHashTableRoutes(r, historyService)
 	RegisterBinaryTreeRoutes(r)
+	RegisterHistoryRoutes(r, historyService)
 }
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/stack"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterMultiStackRoutes(r *gin.Engine) {
+
+	h := handlers.NewMultiStackHandler()
+
+	g := r.Group("/multi-stack")
+	{
+		g.POST("/initialize", h.Initialize)
+		g.POST("/push", h.Push)
+		g.GET("/pop", h.Pop)
+		g.GET("/peek", h.Peek)
+		g.GET("/size", h.Size)
+		g.GET("/stats", h.Stats)
+	}
+}
@@ -2,13 +2,14 @@ package routes
 
 import (
 	handlers "golabs/src/handlers/queue"
+	"golabs/src/services/history"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterQueueRoutes(r *gin.Engine) {
+func RegisterQueueRoutes(r *gin.Engine, historyService history.HistoryService) {
 
-	h := handlers.NewQueueHandler()
+	h := handlers.NewQueueHandler(historyService)
 
 	g := r.Group("/queue")
 	{
@@ -19,5 +20,8 @@ func RegisterQueueRoutes(r *gin.Engine) {
 		g.GET("/head", h.Head)
 		g.GET("/size", h.Size)
 		g.GET("/is-empty", h.IsEmpty)
+		g.GET("/contents", h.Contents)
+		g.GET("/peek-at", h.PeekAt)
+		g.GET("/latest", h.Latest)
 	}
 }
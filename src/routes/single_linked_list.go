@@ -23,5 +23,21 @@ func RegisterSingleLinkedListRoutes(r *gin.Engine) {
 		g.DELETE("/remove-at", h.RemoveAt)
 		g.DELETE("/remove-first", h.RemoveFirst)
 		g.DELETE("/remove-last", h.RemoveLast)
+		g.DELETE("/remove-all", h.RemoveAll)
+		g.POST("/dedupe", h.Dedupe)
+		g.POST("/reverse", h.Reverse)
+		g.POST("/sort", h.Sort)
+		g.GET("/size", h.Size)
+		g.GET("/list", h.List)
+		g.POST("/debug/create-cycle", h.CreateCycle)
+		g.POST("/debug/break-cycle", h.BreakCycle)
+		g.GET("/detect-cycle", h.DetectCycle)
+		g.POST("/cursor/open", h.OpenCursor)
+		g.GET("/cursor", h.GetCursor)
+		g.POST("/cursor/next", h.CursorNext)
+		g.POST("/cursor/insert-before", h.CursorInsertBefore)
+		g.POST("/cursor/insert-after", h.CursorInsertAfter)
+		g.DELETE("/cursor/remove", h.CursorRemove)
+		g.DELETE("/cursor/close", h.CloseCursor)
 	}
 }
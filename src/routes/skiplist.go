@@ -12,10 +12,24 @@ func RegisterSkipListRoutes(r *gin.Engine) {
 
 	g := r.Group("/skiplist")
 	{
+		g.POST("/initialize", h.Initialize)
 		g.GET("/seed", h.Seed)
 		g.POST("/insert", h.Insert)
 		g.GET("/search", h.Search)
 		g.GET("/contains", h.Contains)
 		g.DELETE("/delete", h.Delete)
+		g.GET("/range", h.Range)
+		g.GET("/floor", h.Floor)
+		g.GET("/ceiling", h.Ceiling)
+		g.GET("/first", h.First)
+		g.GET("/last", h.Last)
+		g.GET("/next", h.Next)
+		g.GET("/prev", h.Prev)
+		g.GET("/iterate", h.Iterate)
+		g.GET("/rank", h.Rank)
+		g.GET("/by-rank", h.GetByRank)
+		g.GET("/range-by-rank", h.RangeByRank)
+		g.GET("/stats", h.Stats)
+		g.GET("/debug", h.Debug)
 	}
 }
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/sortedset"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterSortedSetRoutes(r *gin.Engine) {
+
+	h := handlers.NewSortedSetHandler()
+
+	g := r.Group("/sorted-set")
+	{
+		g.POST("/zadd", h.ZAdd)
+		g.POST("/zincrby", h.ZIncrBy)
+		g.GET("/zscore", h.ZScore)
+		g.GET("/zrank", h.ZRank)
+		g.GET("/zrange", h.ZRange)
+		g.GET("/zrange-by-score", h.ZRangeByScore)
+		g.GET("/zcard", h.ZCard)
+		g.DELETE("/zrem", h.ZRem)
+	}
+}
@@ -2,13 +2,14 @@ package routes
 
 import (
 	handlers "golabs/src/handlers/stack"
+	"golabs/src/services/history"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterStackRoutes(r *gin.Engine) {
+func RegisterStackRoutes(r *gin.Engine, historyService history.HistoryService) {
 
-	h := handlers.NewStackHandler()
+	h := handlers.NewStackHandler(historyService)
 
 	g := r.Group("/stack")
 	{
@@ -18,5 +19,10 @@ func RegisterStackRoutes(r *gin.Engine) {
 		g.GET("/peek", h.Peek)
 		g.GET("/size", h.Size)
 		g.GET("/is-empty", h.IsEmpty)
+		g.GET("/stats", h.Stats)
+		g.GET("/min", h.Min)
+		g.GET("/max", h.Max)
+		g.POST("/evaluate", h.Evaluate)
+		g.POST("/check-brackets", h.CheckBrackets)
 	}
 }
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/stack"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterStackQueueRoutes(r *gin.Engine) {
+
+	h := handlers.NewStackQueueHandler()
+
+	g := r.Group("/stack-queue")
+	{
+		g.POST("/enqueue", h.Enqueue)
+		g.GET("/dequeue", h.Dequeue)
+		g.GET("/front", h.Front)
+		g.GET("/size", h.Size)
+		g.GET("/is-empty", h.IsEmpty)
+		g.DELETE("/clear", h.Clear)
+		g.GET("/stats", h.Stats)
+	}
+}
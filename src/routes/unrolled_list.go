@@ -0,0 +1,36 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/linkedlist/unrolled"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterUnrolledListRoutes(r *gin.Engine) {
+
+	h := handlers.NewUnrolledLinkedListHandler()
+
+	g := r.Group("/unrolled-list")
+	{
+		g.POST("/initialize", h.Initialize)
+		g.POST("/add-first", h.AddFirst)
+		g.POST("/add-last", h.AddLast)
+		g.GET("/clear", h.Clear)
+		g.POST("/find", h.Find)
+		g.GET("/get-at", h.GetAt)
+		g.POST("/index-of", h.IndexOf)
+		g.POST("/find-last", h.FindLast)
+		g.POST("/last-index-of", h.LastIndexOf)
+		g.POST("/insert-after", h.InsertAfter)
+		g.POST("/insert-at", h.InsertAt)
+		g.DELETE("/remove", h.Remove)
+		g.DELETE("/remove-at", h.RemoveAt)
+		g.DELETE("/remove-first", h.RemoveFirst)
+		g.DELETE("/remove-last", h.RemoveLast)
+		g.GET("/size", h.Size)
+		g.GET("/list", h.List)
+		g.GET("/view", h.View)
+		g.GET("/sublist", h.Sublist)
+		g.GET("/stats", h.Stats)
+	}
+}
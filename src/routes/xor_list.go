@@ -0,0 +1,24 @@
+package routes
+
+import (
+	handlers "golabs/src/handlers/linkedlist/xor"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterXorListRoutes(r *gin.Engine) {
+
+	h := handlers.NewXorLinkedListHandler()
+
+	g := r.Group("/xor-list")
+	{
+		g.POST("/add-first", h.AddFirst)
+		g.POST("/add-last", h.AddLast)
+		g.DELETE("/remove-first", h.RemoveFirst)
+		g.DELETE("/remove-last", h.RemoveLast)
+		g.GET("/clear", h.Clear)
+		g.GET("/list", h.List)
+		g.GET("/size", h.Size)
+		g.GET("/stats", h.Stats)
+	}
+}
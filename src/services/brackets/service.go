@@ -0,0 +1,230 @@
+package brackets
+
+import (
+	"errors"
+	"fmt"
+
+	"golabs/src/services/stack"
+)
+
+var ErrInvalidSyntax = errors.New("bracket syntax is invalid")
+
+// Syntax picks what besides brackets the checker understands.
+type Syntax string
+
+const (
+	// SyntaxText treats quotes and slashes as ordinary characters, so prose
+	// like "don't (" or "http://x (" is checked bracket by bracket.
+	SyntaxText Syntax = "text"
+	// SyntaxCode also skips "...", '...' and `...` strings with backslash
+	// escapes, // line comments and /* */ block comments.
+	SyntaxCode Syntax = "code"
+)
+
+var pairs = map[string]string{
+	"(":  ")",
+	"[":  "]",
+	"{":  "}",
+	"<":  ">",
+	"\"": "\"",
+	"'":  "'",
+	"`":  "`",
+	"/*": "*/",
+}
+
+var closers = map[string]string{
+	")": "(",
+	"]": "[",
+	"}": "{",
+	">": "<",
+}
+
+// Delimiter is an opening delimiter together with where it was found. Lines
+// and columns are 1-based and columns count characters, not bytes.
+type Delimiter struct {
+	Value  string
+	Line   int
+	Column int
+}
+
+// Mismatch describes the first closing delimiter that did not match. Opening
+// is nil when there was nothing left on the stack to close.
+type Mismatch struct {
+	Found    string
+	Expected string
+	Line     int
+	Column   int
+	Opening  *Delimiter
+	Message  string
+}
+
+type Report struct {
+	Balanced bool
+	Mismatch *Mismatch
+	Unclosed []Delimiter
+	MaxDepth int
+}
+
+type BracketService interface {
+	Check(text string, syntax Syntax) (Report, error)
+}
+
+type bracketService struct{}
+
+func NewBracketService() BracketService {
+	return &bracketService{}
+}
+
+// Check implements BracketService.
+// An empty syntax means SyntaxText. Under SyntaxCode quoted strings and
+// comments are skipped as a whole, so delimiters inside them are ignored.
+// Strings and block comments are still pushed while open, which is how an
+// unterminated one ends up in Unclosed.
+func (s *bracketService) Check(text string, syntax Syntax) (Report, error) {
+	if syntax == "" {
+		syntax = SyntaxText
+	}
+
+	if syntax != SyntaxText && syntax != SyntaxCode {
+		return Report{}, ErrInvalidSyntax
+	}
+
+	code := syntax == SyntaxCode
+	chars := []rune(text)
+	opened := stack.NewGrowable()
+	report := Report{}
+
+	line, column := 1, 0
+	for i := 0; i < len(chars); i++ {
+		char := string(chars[i])
+		column++
+
+		if chars[i] == '\n' {
+			line++
+			column = 0
+			continue
+		}
+
+		if code {
+			if top, ok := peek(opened); ok && isQuote(top.Value) {
+				if char == "\\" && i+1 < len(chars) && chars[i+1] != '\n' {
+					i++
+					column++
+					continue
+				}
+				if char == top.Value {
+					opened.Pop()
+				}
+				continue
+			}
+
+			if top, ok := peek(opened); ok && top.Value == "/*" {
+				if char == "*" && next(chars, i) == '/' {
+					i++
+					column++
+					opened.Pop()
+				}
+				continue
+			}
+
+			if char == "/" && next(chars, i) == '/' {
+				for i+1 < len(chars) && chars[i+1] != '\n' {
+					i++
+				}
+				continue
+			}
+
+			if char == "/" && next(chars, i) == '*' {
+				push(opened, Delimiter{Value: "/*", Line: line, Column: column}, &report)
+				i++
+				column++
+				continue
+			}
+		}
+
+		if _, ok := pairs[char]; ok && (code || !isQuote(char)) {
+			push(opened, Delimiter{Value: char, Line: line, Column: column}, &report)
+			continue
+		}
+
+		if opening, ok := closers[char]; ok {
+			top, ok := peek(opened)
+			if !ok {
+				report.Mismatch = &Mismatch{
+					Found:   char,
+					Line:    line,
+					Column:  column,
+					Message: fmt.Sprintf("unexpected '%s' with nothing left to close", char),
+				}
+				break
+			}
+			if top.Value != opening {
+				report.Mismatch = &Mismatch{
+					Found:    char,
+					Expected: pairs[top.Value],
+					Line:     line,
+					Column:   column,
+					Opening:  &top,
+					Message: fmt.Sprintf("expected '%s' to close '%s' from line %d, column %d but found '%s'",
+						pairs[top.Value], top.Value, top.Line, top.Column, char),
+				}
+				break
+			}
+			opened.Pop()
+		}
+	}
+
+	report.Unclosed = unclosed(opened)
+	report.Balanced = report.Mismatch == nil && len(report.Unclosed) == 0
+
+	return report, nil
+}
+
+/* Utils */
+
+// The stack only holds strings, so each entry carries its position as
+// "value line:column".
+func encode(delimiter Delimiter) string {
+	return fmt.Sprintf("%s %d:%d", delimiter.Value, delimiter.Line, delimiter.Column)
+}
+
+func decode(entry string) Delimiter {
+	var delimiter Delimiter
+	fmt.Sscanf(entry, "%s %d:%d", &delimiter.Value, &delimiter.Line, &delimiter.Column)
+	return delimiter
+}
+
+func push(opened stack.StackService, delimiter Delimiter, report *Report) {
+	opened.Push(encode(delimiter))
+	if size, _ := opened.Size(); size > report.MaxDepth {
+		report.MaxDepth = size
+	}
+}
+
+func peek(opened stack.StackService) (Delimiter, bool) {
+	entry, err := opened.Peek()
+	if err != nil {
+		return Delimiter{}, false
+	}
+	return decode(entry), true
+}
+
+func unclosed(opened stack.StackService) []Delimiter {
+	entries, _ := opened.ToSlice()
+	delimiters := make([]Delimiter, len(entries))
+	for i, entry := range entries {
+		delimiters[i] = decode(entry)
+	}
+	return delimiters
+}
+
+func isQuote(value string) bool {
+	return value == "\"" || value == "'" || value == "`"
+}
+
+func next(chars []rune, i int) rune {
+	if i+1 < len(chars) {
+		return chars[i+1]
+	}
+	return 0
+}
@@ -0,0 +1,136 @@
+package brackets
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		syntax   Syntax
+		balanced bool
+		mismatch *Mismatch
+		unclosed []Delimiter
+		maxDepth int
+	}{
+		{
+			name:     "nested brackets",
+			text:     "{[(<>)]}",
+			balanced: true,
+			maxDepth: 4,
+		},
+		{
+			name:     "mismatch on a later line",
+			text:     "(a\n  [b)",
+			mismatch: &Mismatch{Found: ")", Expected: "]", Line: 2, Column: 5, Opening: &Delimiter{Value: "[", Line: 2, Column: 3}},
+			unclosed: []Delimiter{{Value: "(", Line: 1, Column: 1}, {Value: "[", Line: 2, Column: 3}},
+			maxDepth: 2,
+		},
+		{
+			name:     "columns count characters",
+			text:     "éé)",
+			mismatch: &Mismatch{Found: ")", Line: 1, Column: 3},
+		},
+		{
+			name:     "unclosed brackets stay in order",
+			text:     "({\n[",
+			unclosed: []Delimiter{{Value: "(", Line: 1, Column: 1}, {Value: "{", Line: 1, Column: 2}, {Value: "[", Line: 2, Column: 1}},
+			maxDepth: 3,
+		},
+		{
+			name:     "text ignores apostrophes",
+			text:     "don't (",
+			unclosed: []Delimiter{{Value: "(", Line: 1, Column: 7}},
+			maxDepth: 1,
+		},
+		{
+			name:     "text ignores slashes",
+			text:     "http://x (",
+			unclosed: []Delimiter{{Value: "(", Line: 1, Column: 10}},
+			maxDepth: 1,
+		},
+		{
+			name:     "code skips brackets in strings",
+			text:     `f("(", ')')`,
+			syntax:   SyntaxCode,
+			balanced: true,
+			maxDepth: 2,
+		},
+		{
+			name:     "code honours escaped quotes",
+			text:     `"a\"(" )`,
+			syntax:   SyntaxCode,
+			mismatch: &Mismatch{Found: ")", Line: 1, Column: 8},
+			maxDepth: 1,
+		},
+		{
+			name:     "code reports an unterminated string",
+			text:     "x(\n\"abc)",
+			syntax:   SyntaxCode,
+			unclosed: []Delimiter{{Value: "(", Line: 1, Column: 2}, {Value: "\"", Line: 2, Column: 1}},
+			maxDepth: 2,
+		},
+		{
+			name:     "code skips line comments",
+			text:     "( // )\n)",
+			syntax:   SyntaxCode,
+			balanced: true,
+			maxDepth: 1,
+		},
+		{
+			name:     "code skips block comments across lines",
+			text:     "/* (\n] */ []",
+			syntax:   SyntaxCode,
+			balanced: true,
+			maxDepth: 1,
+		},
+		{
+			name:     "code reports an unterminated block comment",
+			text:     "[ /* ]",
+			syntax:   SyntaxCode,
+			unclosed: []Delimiter{{Value: "[", Line: 1, Column: 1}, {Value: "/*", Line: 1, Column: 3}},
+			maxDepth: 2,
+		},
+	}
+
+	service := NewBracketService()
+	for _, tt := range tests {
+		report, err := service.Check(tt.text, tt.syntax)
+		if err != nil {
+			t.Fatalf("%s: Check() = %v", tt.name, err)
+		}
+
+		if report.Balanced != tt.balanced {
+			t.Fatalf("%s: Balanced = %v, want %v", tt.name, report.Balanced, tt.balanced)
+		}
+		if report.MaxDepth != tt.maxDepth {
+			t.Fatalf("%s: MaxDepth = %d, want %d", tt.name, report.MaxDepth, tt.maxDepth)
+		}
+		if !slices.Equal(report.Unclosed, tt.unclosed) && len(report.Unclosed)+len(tt.unclosed) > 0 {
+			t.Fatalf("%s: Unclosed = %+v, want %+v", tt.name, report.Unclosed, tt.unclosed)
+		}
+
+		if (report.Mismatch == nil) != (tt.mismatch == nil) {
+			t.Fatalf("%s: Mismatch = %+v, want %+v", tt.name, report.Mismatch, tt.mismatch)
+		}
+		if tt.mismatch == nil {
+			continue
+		}
+
+		got, want := *report.Mismatch, *tt.mismatch
+		if got.Found != want.Found || got.Expected != want.Expected || got.Line != want.Line || got.Column != want.Column {
+			t.Fatalf("%s: Mismatch = %+v, want %+v", tt.name, got, want)
+		}
+		if (got.Opening == nil) != (want.Opening == nil) || got.Opening != nil && *got.Opening != *want.Opening {
+			t.Fatalf("%s: Mismatch.Opening = %+v, want %+v", tt.name, got.Opening, want.Opening)
+		}
+	}
+}
+
+func TestCheckRejectsUnknownSyntax(t *testing.T) {
+	if _, err := NewBracketService().Check("()", "lisp"); err != ErrInvalidSyntax {
+		t.Fatalf("Check() with unknown syntax = %v, want %v", err, ErrInvalidSyntax)
+	}
+}
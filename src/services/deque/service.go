@@ -0,0 +1,256 @@
+package deque
+
+import (
+	"errors"
+
+	"golabs/src/services/ring"
+)
+
+var (
+	ErrUninitialized   = errors.New("deque is not initialized")
+	ErrEmpty           = errors.New("deque is empty")
+	ErrFull            = errors.New("deque is full")
+	ErrIndexOutOfRange = errors.New("deque index out of range")
+	ErrInvalidMode     = errors.New("deque mode is invalid")
+)
+
+type Mode string
+
+const (
+	// ModeBounded keeps the capacity fixed and rejects pushes once full.
+	ModeBounded Mode = "bounded"
+	// ModeGrowable doubles the ring instead of returning ErrFull.
+	ModeGrowable Mode = "growable"
+)
+
+const defaultCapacity = 10
+
+type DequeService interface {
+	Initialize(capacity int, mode Mode) error
+
+	// Insertion Methods
+	PushFront(value string) error
+	PushBack(value string) error
+
+	// Deletion Methods
+	PopFront() (string, error)
+	PopBack() (string, error)
+
+	// Accessibility Methods
+	PeekFront() (string, error)
+	PeekBack() (string, error)
+	Get(index int) (string, error)
+	ToSlice() ([]string, error)
+
+	// Utility Methods
+	Size() (int, error)
+	Capacity() (int, error)
+	IsEmpty() (bool, error)
+}
+
+// deque is a ring buffer laid out like the queue's: head is the slot of the
+// front element and logical index i lives at (head + i) % capacity, so both
+// ends move with the same modular step.
+type deque struct {
+	elements []string
+	head     int
+	size     int
+	mode     Mode
+}
+
+func NewDequeService() DequeService {
+	return &deque{}
+}
+
+func (d *deque) Initialize(capacity int, mode Mode) error {
+	if mode == "" {
+		mode = ModeBounded
+	}
+
+	if mode != ModeBounded && mode != ModeGrowable {
+		return ErrInvalidMode
+	}
+
+	if capacity <= 0 {
+		capacity = defaultCapacity
+	}
+
+	*d = deque{
+		elements: make([]string, capacity),
+		mode:     mode,
+	}
+
+	return nil
+}
+
+func (d *deque) PushFront(value string) error {
+	if err := d.makeRoom(); err != nil {
+		return err
+	}
+
+	d.head = d.slot(-1)
+	d.elements[d.head] = value
+	d.size++
+
+	return nil
+}
+
+func (d *deque) PushBack(value string) error {
+	if err := d.makeRoom(); err != nil {
+		return err
+	}
+
+	d.elements[d.slot(d.size)] = value
+	d.size++
+
+	return nil
+}
+
+func (d *deque) PopFront() (string, error) {
+	if err := d.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	value := d.elements[d.head]
+	d.elements[d.head] = ""
+
+	d.head = d.slot(1)
+	d.size--
+
+	return value, nil
+}
+
+func (d *deque) PopBack() (string, error) {
+	if err := d.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	last := d.slot(d.size - 1)
+	value := d.elements[last]
+	d.elements[last] = ""
+
+	d.size--
+
+	return value, nil
+}
+
+func (d *deque) PeekFront() (string, error) {
+	if err := d.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return d.elements[d.head], nil
+}
+
+func (d *deque) PeekBack() (string, error) {
+	if err := d.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return d.elements[d.slot(d.size-1)], nil
+}
+
+func (d *deque) Get(index int) (string, error) {
+	if err := d.validateInitialized(); err != nil {
+		return "", err
+	}
+
+	if index < 0 || index >= d.size {
+		return "", ErrIndexOutOfRange
+	}
+
+	return d.elements[d.slot(index)], nil
+}
+
+// ToSlice returns the elements from front to back.
+func (d *deque) ToSlice() ([]string, error) {
+	if err := d.validateInitialized(); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, d.size)
+	for i := range values {
+		values[i] = d.elements[d.slot(i)]
+	}
+
+	return values, nil
+}
+
+func (d *deque) Size() (int, error) {
+	if err := d.validateInitialized(); err != nil {
+		return 0, err
+	}
+
+	return d.size, nil
+}
+
+func (d *deque) Capacity() (int, error) {
+	if err := d.validateInitialized(); err != nil {
+		return 0, err
+	}
+
+	return len(d.elements), nil
+}
+
+func (d *deque) IsEmpty() (bool, error) {
+	if err := d.validateInitialized(); err != nil {
+		return true, err
+	}
+
+	return d.size == 0, nil
+}
+
+/* Private Methods */
+
+// slot maps an offset from the front, in [-1, capacity], onto the ring.
+func (d *deque) slot(offset int) int {
+	return ring.Slot(d.head, offset, len(d.elements))
+}
+
+// makeRoom grows a full growable deque and rejects a full bounded one.
+// Growing unrolls the ring so the front lands back at index 0.
+func (d *deque) makeRoom() error {
+	if err := d.validateInitialized(); err != nil {
+		return err
+	}
+
+	if d.size < len(d.elements) {
+		return nil
+	}
+
+	if d.mode != ModeGrowable {
+		return ErrFull
+	}
+
+	elements := make([]string, len(d.elements)*2)
+	for i := 0; i < d.size; i++ {
+		elements[i] = d.elements[d.slot(i)]
+	}
+
+	d.elements = elements
+	d.head = 0
+
+	return nil
+}
+
+/* Validations */
+
+func (d *deque) validateInitialized() error {
+	if d.elements == nil {
+		return ErrUninitialized
+	}
+
+	return nil
+}
+
+func (d *deque) validateEmpty() error {
+	if err := d.validateInitialized(); err != nil {
+		return err
+	}
+
+	if d.size == 0 {
+		return ErrEmpty
+	}
+
+	return nil
+}
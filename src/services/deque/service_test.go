@@ -0,0 +1,151 @@
+package deque
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestDequePopBackAcrossHeadSeam(t *testing.T) {
+	d := NewDequeService()
+	d.Initialize(4, ModeBounded)
+
+	// The front push wraps the head to the last slot, so the back pushes sit
+	// on the other side of the seam at slots 0 and 1.
+	d.PushFront("a")
+	d.PushBack("b")
+	d.PushBack("c")
+
+	if value, err := d.Get(0); err != nil || value != "a" {
+		t.Fatalf("Get(0) = %q, %v, want %q", value, err, "a")
+	}
+	if value, err := d.Get(2); err != nil || value != "c" {
+		t.Fatalf("Get(2) = %q, %v, want %q", value, err, "c")
+	}
+
+	for _, want := range []string{"c", "b", "a"} {
+		if value, err := d.PopBack(); err != nil || value != want {
+			t.Fatalf("PopBack() = %q, %v, want %q", value, err, want)
+		}
+	}
+
+	if _, err := d.PopBack(); err != ErrEmpty {
+		t.Fatalf("PopBack() on empty deque = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestDequeAlternatingEndsWrapAround(t *testing.T) {
+	d := NewDequeService()
+	d.Initialize(3, ModeBounded)
+
+	// Each round pushes at one end and pops at the other, so the head walks
+	// the whole ring in both directions several times over.
+	want := []string{}
+	for round := 0; round < 12; round++ {
+		value := strconv.Itoa(round)
+
+		if round%2 == 0 {
+			d.PushFront(value)
+			want = slices.Insert(want, 0, value)
+		} else {
+			d.PushBack(value)
+			want = append(want, value)
+		}
+
+		if len(want) == 3 {
+			if round%2 == 0 {
+				d.PopBack()
+				want = want[:len(want)-1]
+			} else {
+				d.PopFront()
+				want = want[1:]
+			}
+		}
+
+		if values, err := d.ToSlice(); err != nil || !slices.Equal(values, want) {
+			t.Fatalf("round %d: ToSlice() = %q, %v, want %q", round, values, err, want)
+		}
+	}
+}
+
+func TestDequeBoundedRejectsPushWhenFull(t *testing.T) {
+	d := NewDequeService()
+	d.Initialize(2, ModeBounded)
+
+	d.PushBack("a")
+	d.PushFront("b")
+
+	if err := d.PushFront("c"); err != ErrFull {
+		t.Fatalf("PushFront() on full deque = %v, want %v", err, ErrFull)
+	}
+	if err := d.PushBack("c"); err != ErrFull {
+		t.Fatalf("PushBack() on full deque = %v, want %v", err, ErrFull)
+	}
+
+	if values, _ := d.ToSlice(); !slices.Equal(values, []string{"b", "a"}) {
+		t.Fatalf("ToSlice() = %q, want %q", values, []string{"b", "a"})
+	}
+}
+
+func TestDequeGrowsWhileWrapped(t *testing.T) {
+	d := NewDequeService()
+	d.Initialize(4, ModeGrowable)
+
+	// Front pushes wrap the head to the last slot, so the ring is split when
+	// the fifth push doubles it.
+	d.PushBack("c")
+	d.PushBack("d")
+	d.PushFront("b")
+	d.PushFront("a")
+	d.PushBack("e")
+
+	want := []string{"a", "b", "c", "d", "e"}
+	if values, err := d.ToSlice(); err != nil || !slices.Equal(values, want) {
+		t.Fatalf("ToSlice() = %q, %v, want %q", values, err, want)
+	}
+	if capacity, _ := d.Capacity(); capacity != 8 {
+		t.Fatalf("Capacity() = %d, want 8", capacity)
+	}
+	if value, _ := d.PeekBack(); value != "e" {
+		t.Fatalf("PeekBack() = %q, want %q", value, "e")
+	}
+}
+
+func TestGrowableDequeRandomEnds(t *testing.T) {
+	d := NewDequeService()
+	d.Initialize(1, ModeGrowable)
+
+	var want []string
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		value := strconv.Itoa(i)
+
+		switch r.Intn(4) {
+		case 0:
+			d.PushFront(value)
+			want = slices.Insert(want, 0, value)
+		case 1:
+			d.PushBack(value)
+			want = append(want, value)
+		case 2:
+			if value, err := d.PopFront(); len(want) > 0 && (err != nil || value != want[0]) {
+				t.Fatalf("step %d: PopFront() = %q, %v, want %q", i, value, err, want[0])
+			}
+			if len(want) > 0 {
+				want = want[1:]
+			}
+		case 3:
+			if value, err := d.PopBack(); len(want) > 0 && (err != nil || value != want[len(want)-1]) {
+				t.Fatalf("step %d: PopBack() = %q, %v, want %q", i, value, err, want[len(want)-1])
+			}
+			if len(want) > 0 {
+				want = want[:len(want)-1]
+			}
+		}
+
+		if values, _ := d.ToSlice(); !slices.Equal(values, want) && len(values)+len(want) > 0 {
+			t.Fatalf("step %d: ToSlice() = %q, want %q", i, values, want)
+		}
+	}
+}
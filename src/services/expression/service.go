@@ -0,0 +1,365 @@
+package expression
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"golabs/src/services/stack"
+)
+
+const (
+	PhaseConvert  = "convert"
+	PhaseEvaluate = "evaluate"
+)
+
+// negate is how unary minus appears in postfix output and on the operator
+// stack, so it cannot be confused with subtraction.
+const negate = "neg"
+
+// Error points at the character of the expression that made it invalid.
+type Error struct {
+	Position int
+	Message  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+type ExpressionService interface {
+	Evaluate(expression string) (Result, error)
+}
+
+type Result struct {
+	Value   float64
+	Tokens  []string
+	Postfix []string
+	Trace   []Step
+}
+
+// Step is one entry of the trace. Conversion steps fill Operators and
+// Output; evaluation steps fill Operands.
+type Step struct {
+	Phase     string
+	Token     string
+	Action    string
+	Operators []string
+	Output    []string
+	Operands  []string
+}
+
+type token struct {
+	text     string
+	position int
+	number   bool
+}
+
+type operator struct {
+	precedence int
+	rightAssoc bool
+}
+
+// Unary minus binds tighter than * and / but looser than ^, so -2^2 is -4
+// and 2^-1 is 0.5.
+var operators = map[string]operator{
+	"+":    {precedence: 1},
+	"-":    {precedence: 1},
+	"*":    {precedence: 2},
+	"/":    {precedence: 2},
+	negate: {precedence: 3, rightAssoc: true},
+	"^":    {precedence: 4, rightAssoc: true},
+}
+
+type evaluator struct{}
+
+func NewExpressionService() ExpressionService {
+	return &evaluator{}
+}
+
+// Evaluate implements ExpressionService.
+// It tokenizes the expression, converts it to postfix with the
+// shunting-yard algorithm and evaluates the postfix form, recording the
+// stacks after every token.
+func (e *evaluator) Evaluate(expression string) (Result, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{Tokens: make([]string, 0, len(tokens))}
+	for _, current := range tokens {
+		result.Tokens = append(result.Tokens, current.text)
+	}
+
+	postfix := toPostfix(tokens, &result.Trace)
+
+	result.Postfix = make([]string, 0, len(postfix))
+	for _, current := range postfix {
+		result.Postfix = append(result.Postfix, current.text)
+	}
+
+	result.Value, err = evaluatePostfix(postfix, &result.Trace)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, nil
+}
+
+/* Private Methods */
+
+// tokenize splits the expression and checks that operands and operators
+// alternate, which is where most malformed input is caught. A '-' where an
+// operand is expected is unary.
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	var openParens []int
+	expectOperand := true
+
+	for i := 0; i < len(expression); {
+		char := expression[i]
+
+		switch {
+		case char == ' ' || char == '\t':
+			i++
+			continue
+
+		case isDigit(char) || char == '.':
+			if !expectOperand {
+				return nil, &Error{Position: i, Message: "expected an operator or ')'"}
+			}
+
+			start := i
+			for i < len(expression) && (isDigit(expression[i]) || expression[i] == '.') {
+				i++
+			}
+
+			text := expression[start:i]
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, &Error{Position: start, Message: fmt.Sprintf("invalid number %q", text)}
+			}
+
+			tokens = append(tokens, token{text: text, position: start, number: true})
+			expectOperand = false
+			continue
+
+		case char == '(':
+			if !expectOperand {
+				return nil, &Error{Position: i, Message: "expected an operator or ')'"}
+			}
+			openParens = append(openParens, i)
+
+		case char == ')':
+			if expectOperand {
+				return nil, &Error{Position: i, Message: "expected a number or '('"}
+			}
+			if len(openParens) == 0 {
+				return nil, &Error{Position: i, Message: "unmatched ')'"}
+			}
+			openParens = openParens[:len(openParens)-1]
+
+		case char == '-' && expectOperand:
+			tokens = append(tokens, token{text: negate, position: i})
+			i++
+			continue
+
+		case char == '+' || char == '-' || char == '*' || char == '/' || char == '^':
+			if expectOperand {
+				return nil, &Error{Position: i, Message: "expected a number or '('"}
+			}
+			expectOperand = true
+
+		default:
+			return nil, &Error{Position: i, Message: fmt.Sprintf("unexpected character %q", char)}
+		}
+
+		tokens = append(tokens, token{text: string(char), position: i})
+		i++
+	}
+
+	if len(openParens) > 0 {
+		return nil, &Error{Position: openParens[len(openParens)-1], Message: "unclosed '('"}
+	}
+
+	if expectOperand {
+		return nil, &Error{Position: len(expression), Message: "expression ends unexpectedly"}
+	}
+
+	return tokens, nil
+}
+
+// toPostfix is the shunting-yard algorithm. Operators wait on a stack until
+// an operator that binds less tightly, a ')' or the end of the input sends
+// them to the output. Tokens are already known to be well formed. The stack
+// is growable, since an expression has no natural bound on how deep its
+// operators nest.
+func toPostfix(tokens []token, trace *[]Step) []token {
+	operatorStack := stack.NewGrowable()
+	var output []token
+
+	// The stack only holds text, so positions of the operators waiting on
+	// it are kept alongside in the same order.
+	var positions []int
+
+	pop := func() token {
+		text, _ := operatorStack.Pop()
+		position := positions[len(positions)-1]
+		positions = positions[:len(positions)-1]
+		return token{text: text, position: position}
+	}
+
+	push := func(current token) {
+		operatorStack.Push(current.text)
+		positions = append(positions, current.position)
+	}
+
+	record := func(current token, action string) {
+		operatorValues, _ := operatorStack.ToSlice()
+		outputValues := make([]string, 0, len(output))
+		for _, outputToken := range output {
+			outputValues = append(outputValues, outputToken.text)
+		}
+
+		*trace = append(*trace, Step{
+			Phase:     PhaseConvert,
+			Token:     current.text,
+			Action:    action,
+			Operators: operatorValues,
+			Output:    outputValues,
+		})
+	}
+
+	for _, current := range tokens {
+		switch {
+		case current.number:
+			output = append(output, current)
+			record(current, "output number")
+
+		case current.text == "(":
+			push(current)
+			record(current, "push '('")
+
+		case current.text == ")":
+			for top, _ := operatorStack.Peek(); top != "("; top, _ = operatorStack.Peek() {
+				output = append(output, pop())
+			}
+			pop()
+			record(current, "pop operators until '('")
+
+		case current.text == negate:
+			// A prefix operator has no left operand, so nothing on the
+			// stack can be finished yet.
+			push(current)
+			record(current, "push unary minus")
+
+		default:
+			incoming := operators[current.text]
+			for {
+				top, err := operatorStack.Peek()
+				if err != nil || top == "(" {
+					break
+				}
+
+				waiting := operators[top]
+				if waiting.precedence < incoming.precedence ||
+					(waiting.precedence == incoming.precedence && incoming.rightAssoc) {
+					break
+				}
+
+				output = append(output, pop())
+			}
+
+			push(current)
+			record(current, "push operator")
+		}
+	}
+
+	for empty, _ := operatorStack.IsEmpty(); !empty; empty, _ = operatorStack.IsEmpty() {
+		output = append(output, pop())
+	}
+	record(token{}, "pop remaining operators")
+
+	return output
+}
+
+func evaluatePostfix(postfix []token, trace *[]Step) (float64, error) {
+	operandStack := stack.NewGrowable()
+
+	popNumber := func() float64 {
+		text, _ := operandStack.Pop()
+		value, _ := strconv.ParseFloat(text, 64)
+		return value
+	}
+
+	for _, current := range postfix {
+		action := "push number"
+
+		if !current.number {
+			var value float64
+			if current.text == negate {
+				value = -popNumber()
+				action = "negate top"
+			} else {
+				right, left := popNumber(), popNumber()
+
+				var err error
+				value, err = apply(current, left, right)
+				if err != nil {
+					return 0, err
+				}
+				action = fmt.Sprintf("apply %s to %s and %s", current.text, formatNumber(left), formatNumber(right))
+			}
+
+			operandStack.Push(formatNumber(value))
+		} else {
+			operandStack.Push(current.text)
+		}
+
+		operandValues, _ := operandStack.ToSlice()
+		*trace = append(*trace, Step{
+			Phase:    PhaseEvaluate,
+			Token:    current.text,
+			Action:   action,
+			Operands: operandValues,
+		})
+	}
+
+	return popNumber(), nil
+}
+
+func apply(current token, left float64, right float64) (float64, error) {
+	var value float64
+
+	switch current.text {
+	case "+":
+		value = left + right
+	case "-":
+		value = left - right
+	case "*":
+		value = left * right
+	case "/":
+		if right == 0 {
+			return 0, &Error{Position: current.position, Message: "division by zero"}
+		}
+		value = left / right
+	case "^":
+		value = math.Pow(left, right)
+	}
+
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, &Error{Position: current.position, Message: "result is not a finite real number"}
+	}
+
+	return value, nil
+}
+
+/* Utils */
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
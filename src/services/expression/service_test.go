@@ -0,0 +1,131 @@
+package expression
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		expression string
+		value      float64
+		postfix    []string
+	}{
+		{"1+2*3", 7, []string{"1", "2", "3", "*", "+"}},
+		{"(1+2)*3", 9, []string{"1", "2", "+", "3", "*"}},
+		{"10-4-3", 3, []string{"10", "4", "-", "3", "-"}},
+		{"8/4/2", 1, []string{"8", "4", "/", "2", "/"}},
+		{"2^3^2", 512, []string{"2", "3", "2", "^", "^"}},
+		{"-2^2", -4, []string{"2", "2", "^", "neg"}},
+		{"2^-1", 0.5, []string{"2", "1", "neg", "^"}},
+		{"(-2)^2", 4, []string{"2", "neg", "2", "^"}},
+		{"2*-3^2", -18, []string{"2", "3", "2", "^", "neg", "*"}},
+		{"--3", 3, []string{"3", "neg", "neg"}},
+		{"-(2+3)*2", -10, []string{"2", "3", "+", "neg", "2", "*"}},
+		{" 1.5 * 2 ", 3, []string{"1.5", "2", "*"}},
+	}
+
+	service := NewExpressionService()
+	for _, tt := range tests {
+		result, err := service.Evaluate(tt.expression)
+		if err != nil {
+			t.Fatalf("Evaluate(%q) = %v", tt.expression, err)
+		}
+		if result.Value != tt.value {
+			t.Fatalf("Evaluate(%q) = %v, want %v", tt.expression, result.Value, tt.value)
+		}
+		if !slices.Equal(result.Postfix, tt.postfix) {
+			t.Fatalf("Evaluate(%q).Postfix = %q, want %q", tt.expression, result.Postfix, tt.postfix)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		position   int
+		message    string
+	}{
+		{"", 0, "expression ends unexpectedly"},
+		{"1+", 2, "expression ends unexpectedly"},
+		{"*3", 0, "expected a number or '('"},
+		{"1 2", 2, "expected an operator or ')'"},
+		{"2(3)", 1, "expected an operator or ')'"},
+		{"(1+2", 0, "unclosed '('"},
+		{"((1)+(2", 5, "unclosed '('"},
+		{"1+2)", 3, "unmatched ')'"},
+		{"()", 1, "expected a number or '('"},
+		{"2 $ 3", 2, `unexpected character '$'`},
+		{"1..2", 0, `invalid number "1..2"`},
+		{"1/0", 1, "division by zero"},
+		{"4 + 1/(2-2)", 5, "division by zero"},
+		{"(0-8)^0.5", 5, "result is not a finite real number"},
+	}
+
+	service := NewExpressionService()
+	for _, tt := range tests {
+		_, err := service.Evaluate(tt.expression)
+
+		var expressionErr *Error
+		if !errors.As(err, &expressionErr) {
+			t.Fatalf("Evaluate(%q) = %v, want an *Error", tt.expression, err)
+		}
+		if expressionErr.Position != tt.position || expressionErr.Message != tt.message {
+			t.Fatalf("Evaluate(%q) = %q at %d, want %q at %d", tt.expression, expressionErr.Message, expressionErr.Position, tt.message, tt.position)
+		}
+	}
+}
+
+func TestEvaluateTrace(t *testing.T) {
+	result, err := NewExpressionService().Evaluate("1+2*3")
+	if err != nil {
+		t.Fatalf("Evaluate() = %v", err)
+	}
+
+	if !slices.Equal(result.Tokens, []string{"1", "+", "2", "*", "3"}) {
+		t.Fatalf("Tokens = %q", result.Tokens)
+	}
+
+	want := []Step{
+		{Phase: PhaseConvert, Token: "1", Action: "output number", Output: []string{"1"}},
+		{Phase: PhaseConvert, Token: "+", Action: "push operator", Operators: []string{"+"}, Output: []string{"1"}},
+		{Phase: PhaseConvert, Token: "2", Action: "output number", Operators: []string{"+"}, Output: []string{"1", "2"}},
+		{Phase: PhaseConvert, Token: "*", Action: "push operator", Operators: []string{"+", "*"}, Output: []string{"1", "2"}},
+		{Phase: PhaseConvert, Token: "3", Action: "output number", Operators: []string{"+", "*"}, Output: []string{"1", "2", "3"}},
+		{Phase: PhaseConvert, Action: "pop remaining operators", Output: []string{"1", "2", "3", "*", "+"}},
+		{Phase: PhaseEvaluate, Token: "1", Action: "push number", Operands: []string{"1"}},
+		{Phase: PhaseEvaluate, Token: "2", Action: "push number", Operands: []string{"1", "2"}},
+		{Phase: PhaseEvaluate, Token: "3", Action: "push number", Operands: []string{"1", "2", "3"}},
+		{Phase: PhaseEvaluate, Token: "*", Action: "apply * to 2 and 3", Operands: []string{"1", "6"}},
+		{Phase: PhaseEvaluate, Token: "+", Action: "apply + to 1 and 6", Operands: []string{"7"}},
+	}
+
+	if len(result.Trace) != len(want) {
+		t.Fatalf("len(Trace) = %d, want %d: %+v", len(result.Trace), len(want), result.Trace)
+	}
+	for i, step := range result.Trace {
+		if !stepsEqual(step, want[i]) {
+			t.Fatalf("Trace[%d] = %+v, want %+v", i, step, want[i])
+		}
+	}
+}
+
+func TestEvaluateTraceNegate(t *testing.T) {
+	result, _ := NewExpressionService().Evaluate("-2^2")
+
+	last := result.Trace[len(result.Trace)-1]
+	want := Step{Phase: PhaseEvaluate, Token: "neg", Action: "negate top", Operands: []string{"-4"}}
+	if !stepsEqual(last, want) {
+		t.Fatalf("last Trace step = %+v, want %+v", last, want)
+	}
+}
+
+// stepsEqual treats nil and empty stacks alike, since both mean nothing
+// was waiting.
+func stepsEqual(a Step, b Step) bool {
+	return a.Phase == b.Phase && a.Token == b.Token && a.Action == b.Action &&
+		slices.Equal(a.Operators, b.Operators) &&
+		slices.Equal(a.Output, b.Output) &&
+		slices.Equal(a.Operands, b.Operands)
+}
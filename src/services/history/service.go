@@ -0,0 +1,234 @@
+package history
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"golabs/src/services/stack"
+)
+
+var (
+	ErrNothingToUndo = errors.New("history has nothing to undo")
+	ErrNothingToRedo = errors.New("history has nothing to redo")
+)
+
+// Command is a mutation that has already been applied. Undo reverts it and
+// Redo applies it again; both run against whatever the owning handler
+// currently holds, so a handler that mutates without recording must Forget
+// its service. Commands run outside the history lock and are expected to
+// take their handler's lock themselves.
+type Command struct {
+	Service     string
+	Description string
+	Undo        func() error
+	Redo        func() error
+}
+
+type Entry struct {
+	ID          int
+	Service     string
+	Description string
+}
+
+// Listing holds both stacks, most recent entry first.
+type Listing struct {
+	Undo []Entry
+	Redo []Entry
+}
+
+type HistoryService interface {
+	Record(command Command)
+	Undo() (Entry, error)
+	Redo() (Entry, error)
+	List() Listing
+	Clear()
+	Forget(service string)
+}
+
+// history keeps command ids on two stacks; the commands themselves live in
+// a map because the stack service only stores strings. mu guards the
+// bookkeeping and running serialises Undo and Redo. versions counts the
+// records and forgets per service, so a command that ran while its handler
+// recorded something else can tell it no longer knows where it belongs.
+type history struct {
+	mu       sync.Mutex
+	running  sync.Mutex
+	undo     stack.StackService
+	redo     stack.StackService
+	commands map[int]Command
+	versions map[string]int
+	nextID   int
+}
+
+func NewHistoryService() HistoryService {
+	h := &history{}
+	h.Clear()
+	return h
+}
+
+// Record implements HistoryService.
+// A new command invalidates everything that could have been redone.
+func (h *history) Record(command Command) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.versions[command.Service]++
+	h.nextID++
+	h.commands[h.nextID] = command
+	h.undo.Push(strconv.Itoa(h.nextID))
+
+	for {
+		id, err := h.redo.Pop()
+		if err != nil {
+			break
+		}
+		delete(h.commands, parseID(id))
+	}
+}
+
+// Undo implements HistoryService.
+func (h *history) Undo() (Entry, error) {
+	return h.move(&h.undo, &h.redo, ErrNothingToUndo, func(command Command) error {
+		return command.Undo()
+	})
+}
+
+// Redo implements HistoryService.
+func (h *history) Redo() (Entry, error) {
+	return h.move(&h.redo, &h.undo, ErrNothingToRedo, func(command Command) error {
+		return command.Redo()
+	})
+}
+
+// List implements HistoryService.
+func (h *history) List() Listing {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return Listing{
+		Undo: h.entries(h.undo),
+		Redo: h.entries(h.redo),
+	}
+}
+
+// Clear implements HistoryService.
+func (h *history) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.undo = stack.NewGrowable()
+	h.redo = stack.NewGrowable()
+	h.commands = make(map[int]Command)
+	h.versions = make(map[string]int)
+}
+
+// Forget implements HistoryService.
+// Commands are positional, so once a service changed behind the history's
+// back none of its entries can be trusted to land where they should.
+func (h *history) Forget(service string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.forget(service)
+}
+
+/* Private Methods */
+
+// move pops a command from one stack, runs it and pushes it onto the other.
+// The command runs without mu because it takes its handler's lock, which
+// handlers hold while they call Record. The stacks are passed by address
+// because Clear and Forget replace them. A failing command is dropped: the
+// state it expected is gone, and retrying it would only fail again.
+func (h *history) move(from *stack.StackService, to *stack.StackService, errEmpty error, run func(Command) error) (Entry, error) {
+	h.running.Lock()
+	defer h.running.Unlock()
+
+	h.mu.Lock()
+	top, err := (*from).Pop()
+	if err != nil {
+		h.mu.Unlock()
+		return Entry{}, errEmpty
+	}
+
+	id := parseID(top)
+	command := h.commands[id]
+	version := h.versions[command.Service]
+	h.mu.Unlock()
+
+	err = run(command)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err != nil {
+		delete(h.commands, id)
+		return Entry{}, err
+	}
+
+	// Cleared or forgotten while it ran.
+	if _, found := h.commands[id]; !found {
+		return toEntry(id, command), nil
+	}
+
+	// Its handler recorded in the meantime, so the command's place relative
+	// to that record is unknown.
+	if h.versions[command.Service] != version {
+		h.forget(command.Service)
+		return toEntry(id, command), nil
+	}
+
+	(*to).Push(top)
+
+	return toEntry(id, command), nil
+}
+
+// forget rebuilds both stacks without the service's ids.
+func (h *history) forget(service string) {
+	h.versions[service]++
+	h.undo = h.without(h.undo, service)
+	h.redo = h.without(h.redo, service)
+}
+
+func (h *history) without(ids stack.StackService, service string) stack.StackService {
+	values, _ := ids.ToSlice()
+
+	kept := stack.NewGrowable()
+	for _, value := range values {
+		id := parseID(value)
+		if h.commands[id].Service == service {
+			delete(h.commands, id)
+			continue
+		}
+		kept.Push(value)
+	}
+
+	return kept
+}
+
+func (h *history) entries(ids stack.StackService) []Entry {
+	values, _ := ids.ToSlice()
+
+	entries := make([]Entry, 0, len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		id := parseID(values[i])
+		entries = append(entries, toEntry(id, h.commands[id]))
+	}
+
+	return entries
+}
+
+/* Utils */
+
+func parseID(value string) int {
+	id, _ := strconv.Atoi(value)
+	return id
+}
+
+func toEntry(id int, command Command) Entry {
+	return Entry{
+		ID:          id,
+		Service:     command.Service,
+		Description: command.Description,
+	}
+}
@@ -0,0 +1,191 @@
+package linkedlist
+
+type doubleNode struct {
+	Value string
+	Next  *doubleNode
+	Prev  *doubleNode
+}
+
+// circularDoubleLinkedList only tracks its head: head.Prev is the tail.
+type circularDoubleLinkedList struct {
+	head *doubleNode
+	size int
+}
+
+func NewCircularDoubleLinkedList() CircularLinkedListService {
+	return &circularDoubleLinkedList{}
+}
+
+// AddFirst implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) AddFirst(value string) {
+	l.AddLast(value)
+	l.head = l.head.Prev
+}
+
+// AddLast implements CircularLinkedListService.
+// The new node goes between the tail and the head, which is where the tail
+// of a ring is.
+func (l *circularDoubleLinkedList) AddLast(value string) {
+	newNode := &doubleNode{Value: value}
+
+	if l.head == nil {
+		newNode.Next = newNode
+		newNode.Prev = newNode
+		l.head = newNode
+	} else {
+		newNode.Next = l.head
+		newNode.Prev = l.head.Prev
+		l.head.Prev.Next = newNode
+		l.head.Prev = newNode
+	}
+
+	l.size++
+}
+
+// RemoveFirst implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) RemoveFirst() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return l.unlinkNode(l.head), nil
+}
+
+// RemoveLast implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) RemoveLast() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return l.unlinkNode(l.head.Prev), nil
+}
+
+// Remove implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) Remove(value string) (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	currentNode := l.head
+	for i := 0; i < l.size; i++ {
+		if currentNode.Value == value {
+			return l.unlinkNode(currentNode), nil
+		}
+
+		currentNode = currentNode.Next
+	}
+
+	return "", ErrNotFound
+}
+
+// Clear implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) Clear() {
+	l.head = nil
+	l.size = 0
+}
+
+// Rotate implements CircularLinkedListService.
+// It has the same meaning as the single variant, but walks whichever way
+// round the ring is shorter.
+func (l *circularDoubleLinkedList) Rotate(k int) error {
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	k = normalizeRotation(k, l.size)
+	if k <= l.size/2 {
+		for ; k > 0; k-- {
+			l.head = l.head.Next
+		}
+	} else {
+		for k = l.size - k; k > 0; k-- {
+			l.head = l.head.Prev
+		}
+	}
+
+	return nil
+}
+
+// Josephus implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) Josephus(step int) ([]string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	if step < 1 {
+		return nil, ErrInvalidStep
+	}
+
+	return josephus(l.ToSlice(), step), nil
+}
+
+// Walk implements CircularLinkedListService.
+// It follows Next for steps nodes from the head, wrapping around the tail.
+func (l *circularDoubleLinkedList) Walk(steps int) []string {
+	values := make([]string, 0, max(steps, 0))
+	if l.head == nil {
+		return values
+	}
+
+	currentNode := l.head
+	for i := 0; i < steps; i++ {
+		values = append(values, currentNode.Value)
+		currentNode = currentNode.Next
+	}
+
+	return values
+}
+
+// ToSlice implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) ToSlice() []string {
+	values := make([]string, 0, l.size)
+	if l.head == nil {
+		return values
+	}
+
+	currentNode := l.head
+	for {
+		values = append(values, currentNode.Value)
+		currentNode = currentNode.Next
+
+		if currentNode == l.head {
+			break
+		}
+	}
+
+	return values
+}
+
+// Size implements CircularLinkedListService.
+func (l *circularDoubleLinkedList) Size() int {
+	return l.size
+}
+
+/* Private Methods */
+
+func (l *circularDoubleLinkedList) unlinkNode(currentNode *doubleNode) string {
+	if currentNode.Next == currentNode {
+		l.head = nil
+	} else {
+		currentNode.Prev.Next = currentNode.Next
+		currentNode.Next.Prev = currentNode.Prev
+
+		if currentNode == l.head {
+			l.head = currentNode.Next
+		}
+	}
+
+	l.size--
+
+	return currentNode.Value
+}
+
+/* Validations */
+
+func (l *circularDoubleLinkedList) validateEmpty() error {
+	if l.head == nil {
+		return ErrEmpty
+	}
+
+	return nil
+}
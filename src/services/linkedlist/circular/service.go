@@ -0,0 +1,67 @@
+package linkedlist
+
+import (
+	"errors"
+)
+
+var (
+	ErrEmpty       = errors.New("circular linked list is empty")
+	ErrNotFound    = errors.New("circular linked list node not found")
+	ErrInvalidStep = errors.New("circular linked list step must be positive")
+)
+
+// CircularLinkedListService is implemented by both the singly and the doubly
+// linked variants. Their tail links back to their head, so every traversal
+// stops after one lap instead of at a nil pointer.
+type CircularLinkedListService interface {
+	// Insertion Methods
+	AddFirst(value string)
+	AddLast(value string)
+
+	// Deletion Methods
+	RemoveFirst() (string, error)
+	RemoveLast() (string, error)
+	Remove(value string) (string, error)
+	Clear()
+
+	// Reordering Methods
+	Rotate(k int) error
+
+	// Accessibility Methods
+	Josephus(step int) ([]string, error)
+	Walk(steps int) []string
+	ToSlice() []string
+	Size() int
+}
+
+/* Utils */
+
+// josephus eliminates every step-th value of a ring built from values and
+// returns them in elimination order. It works on its own ring so the list
+// the values came from is left untouched.
+func josephus(values []string, step int) []string {
+	ring := &circularSingleLinkedList{}
+	for _, value := range values {
+		ring.AddLast(value)
+	}
+
+	order := make([]string, 0, len(values))
+	prevNode := ring.tail
+	for ring.size > 0 {
+		// Laps around the remaining ring change nothing, so a huge step
+		// costs no more than one shorter than the ring.
+		for i := 0; i < (step-1)%ring.size; i++ {
+			prevNode = prevNode.Next
+		}
+
+		order = append(order, ring.unlinkAfter(prevNode))
+	}
+
+	return order
+}
+
+// normalizeRotation maps any k, negative or larger than size, onto the
+// equivalent forward rotation in [0, size).
+func normalizeRotation(k int, size int) int {
+	return ((k % size) + size) % size
+}
@@ -0,0 +1,140 @@
+package linkedlist
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+var variants = map[string]func() CircularLinkedListService{
+	"single": NewCircularSingleLinkedList,
+	"double": NewCircularDoubleLinkedList,
+}
+
+func newRingOf(newList func() CircularLinkedListService, values ...string) CircularLinkedListService {
+	l := newList()
+	for _, value := range values {
+		l.AddLast(value)
+	}
+
+	return l
+}
+
+// josephusModel removes from a plain slice, which is slow but obviously
+// right.
+func josephusModel(values []string, step int) []string {
+	values = slices.Clone(values)
+
+	order := []string{}
+	index := 0
+	for len(values) > 0 {
+		index = (index + step - 1) % len(values)
+		order = append(order, values[index])
+		values = slices.Delete(values, index, index+1)
+	}
+
+	return order
+}
+
+func TestJosephus(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		step   int
+		want   []string
+	}{
+		{"classic", []string{"1", "2", "3", "4", "5", "6", "7"}, 3, []string{"3", "6", "2", "7", "5", "1", "4"}},
+		{"step of one keeps order", []string{"a", "b", "c", "d"}, 1, []string{"a", "b", "c", "d"}},
+		{"step larger than the ring", []string{"1", "2", "3", "4", "5"}, 7, []string{"2", "5", "1", "3", "4"}},
+		{"step a multiple of the ring", []string{"a", "b", "c"}, 3, []string{"c", "a", "b"}},
+		{"single value", []string{"a"}, 1, []string{"a"}},
+		{"single value with a large step", []string{"a"}, 1000, []string{"a"}},
+	}
+
+	for variant, newList := range variants {
+		for _, tt := range tests {
+			l := newRingOf(newList, tt.values...)
+
+			order, err := l.Josephus(tt.step)
+			if err != nil || !slices.Equal(order, tt.want) {
+				t.Fatalf("%s %s: Josephus(%d) = %q, %v, want %q", variant, tt.name, tt.step, order, err, tt.want)
+			}
+			if values := l.ToSlice(); !slices.Equal(values, tt.values) {
+				t.Fatalf("%s %s: ToSlice() after Josephus() = %q, want the list untouched", variant, tt.name, values)
+			}
+		}
+	}
+}
+
+func TestJosephusMatchesSliceModel(t *testing.T) {
+	for size := 1; size <= 12; size++ {
+		values := make([]string, size)
+		for i := range values {
+			values[i] = strconv.Itoa(i)
+		}
+
+		for step := 1; step <= 2*size+1; step++ {
+			for variant, newList := range variants {
+				order, _ := newRingOf(newList, values...).Josephus(step)
+				if want := josephusModel(values, step); !slices.Equal(order, want) {
+					t.Fatalf("%s: Josephus(%d) of %d values = %q, want %q", variant, step, size, order, want)
+				}
+			}
+		}
+	}
+}
+
+func TestJosephusRejectsBadInput(t *testing.T) {
+	for variant, newList := range variants {
+		if _, err := newList().Josephus(1); err != ErrEmpty {
+			t.Fatalf("%s: Josephus() on empty list = %v, want %v", variant, err, ErrEmpty)
+		}
+		for _, step := range []int{0, -3} {
+			if _, err := newRingOf(newList, "a").Josephus(step); err != ErrInvalidStep {
+				t.Fatalf("%s: Josephus(%d) = %v, want %v", variant, step, err, ErrInvalidStep)
+			}
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		k      int
+		want   []string
+	}{
+		{"forward by one", []string{"a", "b", "c"}, 1, []string{"b", "c", "a"}},
+		{"backward by one", []string{"a", "b", "c"}, -1, []string{"c", "a", "b"}},
+		{"zero", []string{"a", "b", "c"}, 0, []string{"a", "b", "c"}},
+		{"full lap", []string{"a", "b", "c"}, 3, []string{"a", "b", "c"}},
+		{"oversized forward", []string{"a", "b", "c"}, 7, []string{"b", "c", "a"}},
+		{"oversized backward", []string{"a", "b", "c"}, -5, []string{"b", "c", "a"}},
+		{"past the halfway point", []string{"a", "b", "c", "d", "e"}, 4, []string{"e", "a", "b", "c", "d"}},
+		{"single value", []string{"a"}, -9, []string{"a"}},
+	}
+
+	for variant, newList := range variants {
+		for _, tt := range tests {
+			l := newRingOf(newList, tt.values...)
+
+			if err := l.Rotate(tt.k); err != nil {
+				t.Fatalf("%s %s: Rotate(%d) = %v", variant, tt.name, tt.k, err)
+			}
+			if values := l.ToSlice(); !slices.Equal(values, tt.want) {
+				t.Fatalf("%s %s: Rotate(%d) = %q, want %q", variant, tt.name, tt.k, values, tt.want)
+			}
+
+			// Both ends must follow the rotation for appends to land after
+			// the new last value.
+			l.AddLast("z")
+			if values := l.ToSlice(); !slices.Equal(values, append(slices.Clone(tt.want), "z")) {
+				t.Fatalf("%s %s: AddLast() after Rotate(%d) = %q", variant, tt.name, tt.k, values)
+			}
+		}
+
+		if err := newList().Rotate(1); err != ErrEmpty {
+			t.Fatalf("%s: Rotate() on empty list = %v, want %v", variant, err, ErrEmpty)
+		}
+	}
+}
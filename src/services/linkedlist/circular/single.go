@@ -0,0 +1,185 @@
+package linkedlist
+
+type singleNode struct {
+	Value string
+	Next  *singleNode
+}
+
+// circularSingleLinkedList only tracks its tail: tail.Next is the head, so
+// both ends stay reachable in O(1).
+type circularSingleLinkedList struct {
+	tail *singleNode
+	size int
+}
+
+func NewCircularSingleLinkedList() CircularLinkedListService {
+	return &circularSingleLinkedList{}
+}
+
+// AddFirst implements CircularLinkedListService.
+func (l *circularSingleLinkedList) AddFirst(value string) {
+	newNode := &singleNode{Value: value}
+
+	if l.tail == nil {
+		newNode.Next = newNode
+		l.tail = newNode
+	} else {
+		newNode.Next = l.tail.Next
+		l.tail.Next = newNode
+	}
+
+	l.size++
+}
+
+// AddLast implements CircularLinkedListService.
+// It is AddFirst followed by moving the tail onto the new node.
+func (l *circularSingleLinkedList) AddLast(value string) {
+	l.AddFirst(value)
+	l.tail = l.tail.Next
+}
+
+// RemoveFirst implements CircularLinkedListService.
+func (l *circularSingleLinkedList) RemoveFirst() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return l.unlinkAfter(l.tail), nil
+}
+
+// RemoveLast implements CircularLinkedListService.
+// The node before the tail is only reachable by walking the lap, so this is
+// O(n), as in the non circular single list.
+func (l *circularSingleLinkedList) RemoveLast() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	prevNode := l.tail
+	for prevNode.Next != l.tail {
+		prevNode = prevNode.Next
+	}
+
+	return l.unlinkAfter(prevNode), nil
+}
+
+// Remove implements CircularLinkedListService.
+func (l *circularSingleLinkedList) Remove(value string) (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	prevNode := l.tail
+	for i := 0; i < l.size; i++ {
+		if prevNode.Next.Value == value {
+			return l.unlinkAfter(prevNode), nil
+		}
+
+		prevNode = prevNode.Next
+	}
+
+	return "", ErrNotFound
+}
+
+// Clear implements CircularLinkedListService.
+func (l *circularSingleLinkedList) Clear() {
+	l.tail = nil
+	l.size = 0
+}
+
+// Rotate implements CircularLinkedListService.
+// A positive k moves the head k nodes forward, so [a b c] rotated by 1 is
+// [b c a]; a negative k rotates the other way. Only the tail pointer moves.
+func (l *circularSingleLinkedList) Rotate(k int) error {
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	for i := normalizeRotation(k, l.size); i > 0; i-- {
+		l.tail = l.tail.Next
+	}
+
+	return nil
+}
+
+// Josephus implements CircularLinkedListService.
+func (l *circularSingleLinkedList) Josephus(step int) ([]string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	if step < 1 {
+		return nil, ErrInvalidStep
+	}
+
+	return josephus(l.ToSlice(), step), nil
+}
+
+// Walk implements CircularLinkedListService.
+// It follows Next for steps nodes from the head, wrapping around the tail.
+func (l *circularSingleLinkedList) Walk(steps int) []string {
+	values := make([]string, 0, max(steps, 0))
+	if l.tail == nil {
+		return values
+	}
+
+	currentNode := l.tail.Next
+	for i := 0; i < steps; i++ {
+		values = append(values, currentNode.Value)
+		currentNode = currentNode.Next
+	}
+
+	return values
+}
+
+// ToSlice implements CircularLinkedListService.
+func (l *circularSingleLinkedList) ToSlice() []string {
+	values := make([]string, 0, l.size)
+	if l.tail == nil {
+		return values
+	}
+
+	for currentNode := l.tail.Next; ; currentNode = currentNode.Next {
+		values = append(values, currentNode.Value)
+
+		if currentNode == l.tail {
+			break
+		}
+	}
+
+	return values
+}
+
+// Size implements CircularLinkedListService.
+func (l *circularSingleLinkedList) Size() int {
+	return l.size
+}
+
+/* Private Methods */
+
+func (l *circularSingleLinkedList) unlinkAfter(prevNode *singleNode) string {
+	removedNode := prevNode.Next
+
+	if removedNode == prevNode {
+		l.tail = nil
+	} else {
+		prevNode.Next = removedNode.Next
+		if removedNode == l.tail {
+			l.tail = prevNode
+		}
+	}
+
+	l.size--
+
+	return removedNode.Value
+}
+
+/* Validations */
+
+func (l *circularSingleLinkedList) validateEmpty() error {
+	if l.tail == nil {
+		return ErrEmpty
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package linkedlist
+
+import (
+	"errors"
+)
+
+var (
+	ErrConcurrentModification = errors.New("double linked list was modified outside this iterator")
+	ErrIteratorExhausted      = errors.New("double linked list iterator has no more nodes")
+	ErrNoCurrentNode          = errors.New("double linked list iterator is not on a node")
+)
+
+// Iterator is a cursor over a DoubleLinkedListService that can move both
+// ways. It starts before the head and sits either on a node or, after
+// Remove, in the gap the node left. Every method runs in O(1) and fails with
+// ErrConcurrentModification once the list has been changed by anything other
+// than this iterator.
+type Iterator interface {
+	Next() (string, error)
+	Prev() (string, error)
+	HasNext() bool
+	HasPrev() bool
+	Current() (string, error)
+	Index() int
+	InsertBefore(value string) error
+	InsertAfter(value string) error
+	Remove() (string, error)
+}
+
+type iterator struct {
+	list     *linkedList
+	before   *Node
+	current  *Node
+	index    int
+	modCount int
+}
+
+// Iterator implements DoubleLinkedListService.
+func (l *linkedList) Iterator() Iterator {
+	return &iterator{list: l, index: -1, modCount: l.modCount}
+}
+
+// Next implements Iterator.
+func (it *iterator) Next() (string, error) {
+	if err := it.validateModCount(); err != nil {
+		return "", err
+	}
+
+	nextNode := it.nextNode()
+	if nextNode == nil {
+		return "", ErrIteratorExhausted
+	}
+
+	it.current = nextNode
+	it.index++
+
+	return it.current.Value, nil
+}
+
+// Prev implements Iterator.
+func (it *iterator) Prev() (string, error) {
+	if err := it.validateModCount(); err != nil {
+		return "", err
+	}
+
+	prevNode := it.prevNode()
+	if prevNode == nil {
+		return "", ErrIteratorExhausted
+	}
+
+	// Stepping back from a gap lands on the node before it, which already
+	// has the gap's index.
+	if it.current != nil {
+		it.index--
+	}
+	it.current = prevNode
+
+	return it.current.Value, nil
+}
+
+// HasNext implements Iterator.
+func (it *iterator) HasNext() bool {
+	return it.nextNode() != nil
+}
+
+// HasPrev implements Iterator.
+func (it *iterator) HasPrev() bool {
+	return it.prevNode() != nil
+}
+
+// Current implements Iterator.
+func (it *iterator) Current() (string, error) {
+	if err := it.validateCurrent(); err != nil {
+		return "", err
+	}
+
+	return it.current.Value, nil
+}
+
+// Index implements Iterator.
+// On a node it is the node's index; in a gap it is the index of the node
+// before the gap, or -1 at the start.
+func (it *iterator) Index() int {
+	return it.index
+}
+
+// InsertBefore implements Iterator.
+// The iterator stays on the same node, whose index grows by one.
+func (it *iterator) InsertBefore(value string) error {
+	if err := it.validateCurrent(); err != nil {
+		return err
+	}
+
+	newNode := &Node{Value: value, Prev: it.current.Prev, Next: it.current}
+	if newNode.Prev == nil {
+		it.list.head = newNode
+	} else {
+		newNode.Prev.Next = newNode
+	}
+	it.current.Prev = newNode
+
+	it.index++
+	it.list.size++
+	it.list.modCount++
+	it.sync()
+
+	return nil
+}
+
+// InsertAfter implements Iterator.
+// The new node is the next one Next returns.
+func (it *iterator) InsertAfter(value string) error {
+	if err := it.validateCurrent(); err != nil {
+		return err
+	}
+
+	newNode := &Node{Value: value, Prev: it.current, Next: it.current.Next}
+	if newNode.Next == nil {
+		it.list.tail = newNode
+	} else {
+		newNode.Next.Prev = newNode
+	}
+	it.current.Next = newNode
+
+	it.list.size++
+	it.list.modCount++
+	it.sync()
+
+	return nil
+}
+
+// Remove implements Iterator.
+// The iterator is left in the gap, so Next and Prev continue with the
+// removed node's neighbours.
+func (it *iterator) Remove() (string, error) {
+	if err := it.validateCurrent(); err != nil {
+		return "", err
+	}
+
+	removedValue := it.current.Value
+	it.before = it.current.Prev
+	it.list.unlinkNode(it.current)
+	it.list.size--
+
+	it.current = nil
+	it.index--
+	it.sync()
+
+	return removedValue, nil
+}
+
+/* Private Methods */
+
+func (it *iterator) nextNode() *Node {
+	if it.current != nil {
+		return it.current.Next
+	}
+
+	if it.before != nil {
+		return it.before.Next
+	}
+
+	return it.list.head
+}
+
+func (it *iterator) prevNode() *Node {
+	if it.current != nil {
+		return it.current.Prev
+	}
+
+	return it.before
+}
+
+// sync adopts a change made through the iterator so that it does not
+// invalidate itself. Other iterators over the same list still fail.
+func (it *iterator) sync() {
+	it.modCount = it.list.modCount
+}
+
+/* Validations */
+
+func (it *iterator) validateModCount() error {
+	if it.modCount != it.list.modCount {
+		return ErrConcurrentModification
+	}
+
+	return nil
+}
+
+func (it *iterator) validateCurrent() error {
+	if err := it.validateModCount(); err != nil {
+		return err
+	}
+
+	if it.current == nil {
+		return ErrNoCurrentNode
+	}
+
+	return nil
+}
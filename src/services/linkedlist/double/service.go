@@ -8,6 +8,8 @@ var (
 	ErrEmpty         = errors.New("double linked list is empty")
 	ErrIndexNotFound = errors.New("double linked list index not found")
 	ErrNotFound      = errors.New("double linked list node not found")
+	ErrSameList      = errors.New("double linked list cannot be joined with itself")
+	ErrForeignList   = errors.New("double linked list implementation is not supported")
 )
 
 type DoubleLinkedListService interface {
@@ -28,6 +30,20 @@ type DoubleLinkedListService interface {
 	GetAt(index int) (*Node, error)
 	Find(value string) (*Node, error)
 	IndexOf(value string) (int, error)
+	FindLast(value string) (*Node, error)
+	LastIndexOf(value string) (int, error)
+	ToSlice() []string
+	ToSliceReverse() []string
+	Size() int
+
+	// Relinking Methods
+	Concat(other DoubleLinkedListService) error
+	SplitAt(index int) (DoubleLinkedListService, error)
+	Splice(index int, other DoubleLinkedListService) error
+	Sublist(from int, to int) (DoubleLinkedListService, error)
+
+	// Iteration Methods
+	Iterator() Iterator
 }
 
 type Node struct {
@@ -36,10 +52,17 @@ type Node struct {
 	Prev  *Node
 }
 
+// modCount counts structural changes so iterators can fail fast.
+//
+// Index arguments may be negative and then count from the end, so -1 is the
+// last node. Where size itself is a valid index (InsertAt, SplitAt, Splice,
+// Sublist) a negative index still resolves against size, which keeps the
+// behaviour of Python's list methods.
 type linkedList struct {
-	head *Node
-	tail *Node
-	size int
+	head     *Node
+	tail     *Node
+	size     int
+	modCount int
 }
 
 func NewDoubleLinkedList() DoubleLinkedListService {
@@ -58,6 +81,7 @@ func (l *linkedList) AddFirst(newValue string) {
 	}
 
 	l.size++
+	l.modCount++
 }
 
 // AddLast implements DoubleLinkedListService.
@@ -74,6 +98,7 @@ func (l *linkedList) AddLast(newValue string) {
 	}
 
 	l.size++
+	l.modCount++
 }
 
 // Clear implements DoubleLinkedListService.
@@ -81,6 +106,7 @@ func (l *linkedList) Clear() {
 	l.head = nil
 	l.tail = nil
 	l.size = 0
+	l.modCount++
 }
 
 // Find implements DoubleLinkedListService.
@@ -103,7 +129,12 @@ func (l *linkedList) GetAt(nodeIndex int) (*Node, error) {
 		return nil, err
 	}
 
-	foundNode, _ := l.findByIndex(nodeIndex)
+	nodeIndex, err := l.resolveIndex(nodeIndex, false)
+	if err != nil {
+		return nil, err
+	}
+
+	foundNode := l.findByIndex(nodeIndex)
 
 	if foundNode != nil {
 		return foundNode, nil
@@ -126,6 +157,59 @@ func (l *linkedList) IndexOf(value string) (int, error) {
 	return -1, nil
 }
 
+// FindLast implements DoubleLinkedListService.
+func (l *linkedList) FindLast(value string) (*Node, error) {
+	if err := l.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	foundNode, _ := l.findLastByValue(value)
+
+	if foundNode != nil {
+		return foundNode, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// LastIndexOf implements DoubleLinkedListService.
+func (l *linkedList) LastIndexOf(value string) (int, error) {
+	if err := l.validateEmpty(); err != nil {
+		return -1, err
+	}
+
+	_, index := l.findLastByValue(value)
+
+	return index, nil
+}
+
+// ToSlice implements DoubleLinkedListService.
+func (l *linkedList) ToSlice() []string {
+	values := make([]string, 0, l.size)
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.Next {
+		values = append(values, currentNode.Value)
+	}
+
+	return values
+}
+
+// ToSliceReverse implements DoubleLinkedListService.
+// It walks Prev pointers from the tail, so comparing it with ToSlice checks
+// that both directions are linked consistently.
+func (l *linkedList) ToSliceReverse() []string {
+	values := make([]string, 0, l.size)
+	for currentNode := l.tail; currentNode != nil; currentNode = currentNode.Prev {
+		values = append(values, currentNode.Value)
+	}
+
+	return values
+}
+
+// Size implements DoubleLinkedListService.
+func (l *linkedList) Size() int {
+	return l.size
+}
+
 func (l *linkedList) InsertAfter(searchValue string, newValue string) error {
 	if err := l.validateEmpty(); err != nil {
 		return err
@@ -149,13 +233,15 @@ func (l *linkedList) InsertAfter(searchValue string, newValue string) error {
 	}
 
 	l.size++
+	l.modCount++
 
 	return nil
 }
 
 // InsertAt implements DoubleLinkedListService.
 func (l *linkedList) InsertAt(nodeIndex int, newValue string) error {
-	if err := l.validateIndex(nodeIndex, true); err != nil {
+	nodeIndex, err := l.resolveIndex(nodeIndex, true)
+	if err != nil {
 		return err
 	}
 
@@ -163,6 +249,9 @@ func (l *linkedList) InsertAt(nodeIndex int, newValue string) error {
 
 	if nodeIndex == 0 {
 		newNode.Next = l.head
+		if l.head != nil {
+			l.head.Prev = newNode
+		}
 		l.head = newNode
 
 		if l.tail == nil {
@@ -170,6 +259,7 @@ func (l *linkedList) InsertAt(nodeIndex int, newValue string) error {
 		}
 
 		l.size++
+		l.modCount++
 		return nil
 	}
 
@@ -179,12 +269,15 @@ func (l *linkedList) InsertAt(nodeIndex int, newValue string) error {
 		l.tail = newNode
 
 		l.size++
+		l.modCount++
 
 		return nil
 	}
 
-	foundNode, prevNode := l.findByIndex(nodeIndex)
+	foundNode := l.findByIndex(nodeIndex)
 	if foundNode != nil {
+		prevNode := foundNode.Prev
+
 		newNode.Next = foundNode
 		foundNode.Prev = newNode
 
@@ -192,6 +285,7 @@ func (l *linkedList) InsertAt(nodeIndex int, newValue string) error {
 		prevNode.Next = newNode
 
 		l.size++
+		l.modCount++
 		return nil
 	}
 
@@ -204,14 +298,14 @@ func (l *linkedList) Remove(searchValue string) (string, error) {
 		return "", err
 	}
 
-	foundNode, prevNode, _ := l.findByValue(searchValue)
+	foundNode, _, _ := l.findByValue(searchValue)
 
 	if foundNode == nil {
 		return "", ErrNotFound
 	}
 
 	removedValue := foundNode.Value
-	l.unlinkNode(foundNode, prevNode)
+	l.unlinkNode(foundNode)
 
 	l.size--
 
@@ -224,14 +318,15 @@ func (l *linkedList) RemoveAt(nodeIndex int) (string, error) {
 		return "", err
 	}
 
-	if err := l.validateIndex(nodeIndex, false); err != nil {
+	nodeIndex, err := l.resolveIndex(nodeIndex, false)
+	if err != nil {
 		return "", err
 	}
 
-	foundNode, prevNode := l.findByIndex(nodeIndex)
+	foundNode := l.findByIndex(nodeIndex)
 
 	removedValue := foundNode.Value
-	l.unlinkNode(foundNode, prevNode)
+	l.unlinkNode(foundNode)
 
 	l.size--
 
@@ -245,7 +340,7 @@ func (l *linkedList) RemoveFirst() (string, error) {
 	}
 
 	removedValue := l.head.Value
-	l.unlinkNode(l.head, nil)
+	l.unlinkNode(l.head)
 
 	l.size--
 	return removedValue, nil
@@ -258,12 +353,107 @@ func (l *linkedList) RemoveLast() (string, error) {
 	}
 
 	removedValue := l.tail.Value
-	l.unlinkNode(l.tail, nil)
+	l.unlinkNode(l.tail)
 
 	l.size--
 	return removedValue, nil
 }
 
+// Concat implements DoubleLinkedListService.
+// The nodes of other are moved, not copied, so it runs in O(1) and leaves
+// other empty.
+func (l *linkedList) Concat(other DoubleLinkedListService) error {
+	otherList, err := l.validateOther(other)
+	if err != nil {
+		return err
+	}
+
+	l.linkBefore(nil, otherList)
+
+	return nil
+}
+
+// SplitAt implements DoubleLinkedListService.
+// The list keeps the nodes before index and the returned list takes the
+// rest. Only the walk to index costs anything; the cut itself is O(1).
+func (l *linkedList) SplitAt(nodeIndex int) (DoubleLinkedListService, error) {
+	nodeIndex, err := l.resolveIndex(nodeIndex, true)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := &linkedList{}
+	if nodeIndex == l.size {
+		return suffix, nil
+	}
+
+	foundNode := l.findByIndex(nodeIndex)
+
+	suffix.head = foundNode
+	suffix.tail = l.tail
+	suffix.size = l.size - nodeIndex
+
+	l.tail = foundNode.Prev
+	if l.tail == nil {
+		l.head = nil
+	} else {
+		l.tail.Next = nil
+	}
+	foundNode.Prev = nil
+	l.size = nodeIndex
+	l.modCount++
+
+	return suffix, nil
+}
+
+// Splice implements DoubleLinkedListService.
+// Every node of other is moved in front of index, leaving other empty.
+func (l *linkedList) Splice(nodeIndex int, other DoubleLinkedListService) error {
+	otherList, err := l.validateOther(other)
+	if err != nil {
+		return err
+	}
+
+	nodeIndex, err = l.resolveIndex(nodeIndex, true)
+	if err != nil {
+		return err
+	}
+
+	nextNode := l.findByIndex(nodeIndex)
+	l.linkBefore(nextNode, otherList)
+
+	return nil
+}
+
+// Sublist implements DoubleLinkedListService.
+// It copies the nodes in [from, to) because the list keeps its own; the
+// cost is the walk to from plus one step per copied node.
+func (l *linkedList) Sublist(from int, to int) (DoubleLinkedListService, error) {
+	from, err := l.resolveIndex(from, true)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err = l.resolveIndex(to, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if from > to {
+		return nil, ErrIndexNotFound
+	}
+
+	sublist := &linkedList{}
+
+	currentNode := l.findByIndex(from)
+	for i := from; i < to; i++ {
+		sublist.AddLast(currentNode.Value)
+		currentNode = currentNode.Next
+	}
+
+	return sublist, nil
+}
+
 /* Private Functions */
 
 func (l *linkedList) findByValue(value string) (*Node, *Node, int) {
@@ -284,50 +474,114 @@ func (l *linkedList) findByValue(value string) (*Node, *Node, int) {
 	return nil, nil, -1
 }
 
-func (l *linkedList) findByIndex(index int) (*Node, *Node) {
-	currentNode := l.head
-	var prevNode *Node = nil
+// findByIndex walks from whichever end is closer, so no lookup costs more
+// than size/2 steps. It returns nil for index == size.
+func (l *linkedList) findByIndex(index int) *Node {
+	if index < 0 || index >= l.size {
+		return nil
+	}
 
-	for i := 0; i < index; i++ {
-		if currentNode == nil {
-			return nil, nil
+	if index < l.size/2 {
+		currentNode := l.head
+		for i := 0; i < index; i++ {
+			currentNode = currentNode.Next
 		}
-		prevNode = currentNode
-		currentNode = currentNode.Next
+
+		return currentNode
 	}
 
-	return currentNode, prevNode
+	currentNode := l.tail
+	for i := l.size - 1; i > index; i-- {
+		currentNode = currentNode.Prev
+	}
+
+	return currentNode
 }
 
-func (l *linkedList) unlinkNode(currentNode *Node, prevNode *Node) {
-	if currentNode == l.head && currentNode == l.tail {
-		l.head = nil
-		l.tail = nil
+// findLastByValue is findByValue walking Prev pointers from the tail.
+func (l *linkedList) findLastByValue(value string) (*Node, int) {
+	index := l.size - 1
+	for currentNode := l.tail; currentNode != nil; currentNode = currentNode.Prev {
+		if currentNode.Value == value {
+			return currentNode, index
+		}
 
-		return
+		index--
 	}
 
-	if currentNode == l.head {
+	return nil, -1
+}
+
+// unlinkNode reads the neighbours from the node itself, so callers never
+// need to track the previous node while walking.
+func (l *linkedList) unlinkNode(currentNode *Node) {
+	l.modCount++
+
+	if currentNode.Prev == nil {
 		l.head = currentNode.Next
-		l.head.Prev = nil
-		return
+	} else {
+		currentNode.Prev.Next = currentNode.Next
 	}
 
-	if currentNode == l.tail {
-		l.tail = prevNode
-		if l.tail != nil {
-			l.tail.Next = nil
-			l.tail.Prev = prevNode.Prev
-		}
+	if currentNode.Next == nil {
+		l.tail = currentNode.Prev
+	} else {
+		currentNode.Next.Prev = currentNode.Prev
+	}
+
+	currentNode.Prev = nil
+	currentNode.Next = nil
+}
+
+// linkBefore moves every node of other in front of nextNode, or to the end
+// when nextNode is nil, and leaves other empty. Only the four boundary
+// pointers change, whatever the length of other.
+func (l *linkedList) linkBefore(nextNode *Node, other *linkedList) {
+	if other.head == nil {
 		return
 	}
 
-	prevNode.Next = currentNode.Next
-	prevNode.Next.Prev = currentNode.Prev
+	var prevNode *Node
+	if nextNode == nil {
+		prevNode = l.tail
+	} else {
+		prevNode = nextNode.Prev
+	}
+
+	other.head.Prev = prevNode
+	if prevNode == nil {
+		l.head = other.head
+	} else {
+		prevNode.Next = other.head
+	}
+
+	other.tail.Next = nextNode
+	if nextNode == nil {
+		l.tail = other.tail
+	} else {
+		nextNode.Prev = other.tail
+	}
+
+	l.size += other.size
+	l.modCount++
+	other.Clear()
 }
 
 /* Validations */
 
+func (l *linkedList) validateOther(other DoubleLinkedListService) (*linkedList, error) {
+	otherList, ok := other.(*linkedList)
+	if !ok {
+		return nil, ErrForeignList
+	}
+
+	if otherList == l {
+		return nil, ErrSameList
+	}
+
+	return otherList, nil
+}
+
 func (l *linkedList) validateEmpty() error {
 	if l.head == nil {
 		return ErrEmpty
@@ -336,6 +590,20 @@ func (l *linkedList) validateEmpty() error {
 	return nil
 }
 
+// resolveIndex turns a negative index into its offset from the start and
+// validates the result.
+func (l *linkedList) resolveIndex(index int, allowEqualSize bool) (int, error) {
+	if index < 0 {
+		index += l.size
+	}
+
+	if err := l.validateIndex(index, allowEqualSize); err != nil {
+		return -1, err
+	}
+
+	return index, nil
+}
+
 func (l *linkedList) validateIndex(index int, allowEqualSize bool) error {
 
 	if index < 0 {
@@ -344,7 +612,7 @@ func (l *linkedList) validateIndex(index int, allowEqualSize bool) error {
 	if allowEqualSize && index > l.size {
 		return ErrIndexNotFound
 	}
-	if index > l.size {
+	if !allowEqualSize && index >= l.size {
 		return ErrIndexNotFound
 	}
 	return nil
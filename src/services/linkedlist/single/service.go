@@ -8,6 +8,8 @@ var (
 	ErrEmpty         = errors.New("single linked list is empty")
 	ErrIndexNotFound = errors.New("single linked list index not found")
 	ErrNotFound      = errors.New("single linked list node not found")
+	ErrCycle         = errors.New("single linked list contains a cycle")
+	ErrNoCycle       = errors.New("single linked list has no cycle")
 )
 
 type SingleLinkedListService interface {
@@ -22,23 +24,53 @@ type SingleLinkedListService interface {
 	RemoveLast() (string, error)
 	RemoveAt(index int) (string, error)
 	Remove(value string) (string, error)
+	RemoveAll(value string) (int, error)
+	Dedupe() (int, error)
 	Clear()
 
+	// Reordering Methods
+	Reverse() error
+	Sort() error
+
 	// Accessibility Methods
-	GetAt(index int) (*node, error)
-	Find(value string) (*node, error)
+	GetAt(index int) (*Node, error)
+	Find(value string) (*Node, error)
 	IndexOf(value string) (int, error)
+	ToSlice() []string
+	Size() int
+
+	// Cycle Methods
+	CreateCycle(index int) error
+	BreakCycle() error
+	DetectCycleFloyd() Cycle
+	DetectCycleBrent() Cycle
+
+	// Iteration Methods
+	Iterator() Iterator
 }
 
-type node struct {
+// Cycle describes what a detection algorithm found. Start is the index of
+// the first node inside the cycle and Steps counts the pointer moves the
+// algorithm needed, so Floyd and Brent can be compared on the same list.
+type Cycle struct {
+	Found  bool
+	Start  int
+	Length int
+	Steps  int
+}
+
+type Node struct {
 	Value string
-	Next  *node
+	Next  *Node
 }
 
+// modCount counts structural changes. Iterators remember the value they
+// last saw and fail fast once the list has been changed behind them.
 type linkedList struct {
-	head *node
-	tail *node
-	size int
+	head     *Node
+	tail     *Node
+	size     int
+	modCount int
 }
 
 func NewSingleLinkedList() SingleLinkedListService {
@@ -47,7 +79,7 @@ func NewSingleLinkedList() SingleLinkedListService {
 
 // AddFirst implements SingleLinkedListService.
 func (l *linkedList) AddFirst(newValue string) {
-	newNode := &node{Value: newValue, Next: l.head}
+	newNode := &Node{Value: newValue, Next: l.head}
 
 	l.head = newNode
 
@@ -56,11 +88,13 @@ func (l *linkedList) AddFirst(newValue string) {
 	}
 
 	l.size++
+	l.modCount++
 }
 
 // AddLast implements SingleLinkedListService.
+// Appending replaces the tail's link, so it also breaks an injected cycle.
 func (l *linkedList) AddLast(newValue string) {
-	newNode := &node{Value: newValue, Next: nil}
+	newNode := &Node{Value: newValue, Next: nil}
 
 	if l.tail == nil {
 		l.head = newNode
@@ -71,6 +105,7 @@ func (l *linkedList) AddLast(newValue string) {
 	}
 
 	l.size++
+	l.modCount++
 }
 
 // Clear implements SingleLinkedListService.
@@ -78,10 +113,11 @@ func (l *linkedList) Clear() {
 	l.head = nil
 	l.tail = nil
 	l.size = 0
+	l.modCount++
 }
 
 // Find implements SingleLinkedListService.
-func (l *linkedList) Find(searchValue string) (*node, error) {
+func (l *linkedList) Find(searchValue string) (*Node, error) {
 	if err := l.validateEmpty(); err != nil {
 		return nil, err
 	}
@@ -96,11 +132,15 @@ func (l *linkedList) Find(searchValue string) (*node, error) {
 }
 
 // GetAt implements SingleLinkedListService.
-func (l *linkedList) GetAt(nodeIndex int) (*node, error) {
+func (l *linkedList) GetAt(nodeIndex int) (*Node, error) {
 	if err := l.validateEmpty(); err != nil {
 		return nil, err
 	}
 
+	if err := l.validateIndex(nodeIndex, false); err != nil {
+		return nil, err
+	}
+
 	foundNode, _ := l.findByIndex(nodeIndex)
 
 	if foundNode != nil {
@@ -124,13 +164,34 @@ func (l *linkedList) IndexOf(searchValue string) (int, error) {
 	return -1, nil
 }
 
+// ToSlice implements SingleLinkedListService.
+func (l *linkedList) ToSlice() []string {
+	values := make([]string, 0, l.size)
+	currentNode := l.head
+	for i := 0; i < l.size && currentNode != nil; i++ {
+		values = append(values, currentNode.Value)
+		currentNode = currentNode.Next
+	}
+
+	return values
+}
+
+// Size implements SingleLinkedListService.
+func (l *linkedList) Size() int {
+	return l.size
+}
+
 // InsertAt implements SingleLinkedListService.
 func (l *linkedList) InsertAt(nodeIndex int, value string) error {
+	if err := l.validateAcyclic(); err != nil {
+		return err
+	}
+
 	if err := l.validateIndex(nodeIndex, true); err != nil {
 		return err
 	}
 
-	newNode := &node{Value: value}
+	newNode := &Node{Value: value}
 
 	if nodeIndex == 0 {
 		newNode.Next = l.head
@@ -141,6 +202,7 @@ func (l *linkedList) InsertAt(nodeIndex int, value string) error {
 		}
 
 		l.size++
+		l.modCount++
 		return nil
 	}
 
@@ -149,6 +211,7 @@ func (l *linkedList) InsertAt(nodeIndex int, value string) error {
 		l.tail = newNode
 
 		l.size++
+		l.modCount++
 		return nil
 	}
 
@@ -158,6 +221,7 @@ func (l *linkedList) InsertAt(nodeIndex int, value string) error {
 		prevNode.Next = newNode
 
 		l.size++
+		l.modCount++
 		return nil
 	}
 
@@ -165,6 +229,10 @@ func (l *linkedList) InsertAt(nodeIndex int, value string) error {
 }
 
 func (l *linkedList) InsertAfter(searchValue string, newValue string) error {
+	if err := l.validateAcyclic(); err != nil {
+		return err
+	}
+
 	if err := l.validateEmpty(); err != nil {
 		return err
 	}
@@ -175,7 +243,7 @@ func (l *linkedList) InsertAfter(searchValue string, newValue string) error {
 		return ErrNotFound
 	}
 
-	newNode := &node{Value: newValue, Next: foundNode.Next}
+	newNode := &Node{Value: newValue, Next: foundNode.Next}
 	foundNode.Next = newNode
 
 	if foundNode == l.tail {
@@ -183,12 +251,17 @@ func (l *linkedList) InsertAfter(searchValue string, newValue string) error {
 	}
 
 	l.size++
+	l.modCount++
 
 	return nil
 }
 
 // Remove implements SingleLinkedListService.
 func (l *linkedList) Remove(searchValue string) (string, error) {
+	if err := l.validateAcyclic(); err != nil {
+		return "", err
+	}
+
 	if err := l.validateEmpty(); err != nil {
 		return "", err
 	}
@@ -209,6 +282,10 @@ func (l *linkedList) Remove(searchValue string) (string, error) {
 
 // RemoveAt implements SingleLinkedListService.
 func (l *linkedList) RemoveAt(nodeIndex int) (string, error) {
+	if err := l.validateAcyclic(); err != nil {
+		return "", err
+	}
+
 	if err := l.validateEmpty(); err != nil {
 		return "", err
 	}
@@ -233,6 +310,10 @@ func (l *linkedList) RemoveFirst() (string, error) {
 		return "", err
 	}
 
+	if err := l.validateAcyclic(); err != nil {
+		return "", err
+	}
+
 	removedValue := l.head.Value
 	l.unlinkNode(l.head, nil)
 
@@ -242,6 +323,10 @@ func (l *linkedList) RemoveFirst() (string, error) {
 
 // RemoveLast implements SingleLinkedListService.
 func (l *linkedList) RemoveLast() (string, error) {
+	if err := l.validateAcyclic(); err != nil {
+		return "", err
+	}
+
 	if err := l.validateEmpty(); err != nil {
 		return "", err
 	}
@@ -255,11 +340,258 @@ func (l *linkedList) RemoveLast() (string, error) {
 	return removedValue, nil
 }
 
-func (l *linkedList) findByValue(value string) (*node, *node, int) {
-	index := 0
+// RemoveAll implements SingleLinkedListService.
+func (l *linkedList) RemoveAll(searchValue string) (int, error) {
+	if err := l.validateAcyclic(); err != nil {
+		return 0, err
+	}
+
+	if err := l.validateEmpty(); err != nil {
+		return 0, err
+	}
+
+	removed := 0
 	currentNode := l.head
-	var prevNode *node = nil
+	var prevNode *Node = nil
 	for currentNode != nil {
+		nextNode := currentNode.Next
+
+		if currentNode.Value == searchValue {
+			l.unlinkNode(currentNode, prevNode)
+			l.size--
+			removed++
+		} else {
+			prevNode = currentNode
+		}
+
+		currentNode = nextNode
+	}
+
+	if removed == 0 {
+		return 0, ErrNotFound
+	}
+
+	return removed, nil
+}
+
+// Dedupe implements SingleLinkedListService.
+// The first occurrence of every value is kept.
+func (l *linkedList) Dedupe() (int, error) {
+	if err := l.validateAcyclic(); err != nil {
+		return 0, err
+	}
+
+	if err := l.validateEmpty(); err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	seen := map[string]bool{}
+	currentNode := l.head
+	var prevNode *Node = nil
+	for currentNode != nil {
+		nextNode := currentNode.Next
+
+		if seen[currentNode.Value] {
+			l.unlinkNode(currentNode, prevNode)
+			l.size--
+			removed++
+		} else {
+			seen[currentNode.Value] = true
+			prevNode = currentNode
+		}
+
+		currentNode = nextNode
+	}
+
+	return removed, nil
+}
+
+// Reverse implements SingleLinkedListService.
+func (l *linkedList) Reverse() error {
+	if err := l.validateAcyclic(); err != nil {
+		return err
+	}
+
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	var prevNode *Node = nil
+	currentNode := l.head
+	for currentNode != nil {
+		nextNode := currentNode.Next
+		currentNode.Next = prevNode
+		prevNode = currentNode
+		currentNode = nextNode
+	}
+
+	l.head, l.tail = l.tail, l.head
+	l.modCount++
+
+	return nil
+}
+
+// Sort implements SingleLinkedListService.
+// It is a stable merge sort that relinks the existing nodes.
+func (l *linkedList) Sort() error {
+	if err := l.validateAcyclic(); err != nil {
+		return err
+	}
+
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	l.head = mergeSort(l.head)
+	l.modCount++
+
+	l.tail = l.head
+	for l.tail.Next != nil {
+		l.tail = l.tail.Next
+	}
+
+	return nil
+}
+
+// CreateCycle implements SingleLinkedListService.
+// It links the tail back to the node at index. Operations that relink nodes
+// refuse to run until BreakCycle is called.
+func (l *linkedList) CreateCycle(nodeIndex int) error {
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	if err := l.validateAcyclic(); err != nil {
+		return err
+	}
+
+	if err := l.validateIndex(nodeIndex, false); err != nil {
+		return err
+	}
+
+	foundNode, _ := l.findByIndex(nodeIndex)
+	if foundNode == nil {
+		return ErrIndexNotFound
+	}
+
+	l.tail.Next = foundNode
+	l.modCount++
+
+	return nil
+}
+
+// BreakCycle implements SingleLinkedListService.
+func (l *linkedList) BreakCycle() error {
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	if l.tail.Next == nil {
+		return ErrNoCycle
+	}
+
+	l.tail.Next = nil
+	l.modCount++
+
+	return nil
+}
+
+// DetectCycleFloyd implements SingleLinkedListService.
+// The hare moves two nodes per step and the tortoise one; they can only meet
+// inside a cycle. Restarting the tortoise from head then meets the hare at
+// the cycle start.
+func (l *linkedList) DetectCycleFloyd() Cycle {
+	var cycle Cycle
+
+	slowNode, fastNode := l.head, l.head
+	for fastNode != nil && fastNode.Next != nil {
+		slowNode = slowNode.Next
+		fastNode = fastNode.Next.Next
+		cycle.Steps++
+
+		if slowNode == fastNode {
+			cycle.Found = true
+			break
+		}
+	}
+
+	if !cycle.Found {
+		return cycle
+	}
+
+	slowNode = l.head
+	for slowNode != fastNode {
+		slowNode = slowNode.Next
+		fastNode = fastNode.Next
+		cycle.Start++
+		cycle.Steps++
+	}
+
+	cycle.Length = 1
+	for fastNode = slowNode.Next; fastNode != slowNode; fastNode = fastNode.Next {
+		cycle.Length++
+		cycle.Steps++
+	}
+
+	return cycle
+}
+
+// DetectCycleBrent implements SingleLinkedListService.
+// The tortoise teleports to the hare every power-of-two steps, which finds
+// the cycle length directly; a second pass with the hare Length nodes ahead
+// finds the start.
+func (l *linkedList) DetectCycleBrent() Cycle {
+	var cycle Cycle
+
+	if l.head == nil {
+		return cycle
+	}
+
+	power, length := 1, 1
+	slowNode, fastNode := l.head, l.head.Next
+	for fastNode != nil && fastNode != slowNode {
+		if power == length {
+			slowNode = fastNode
+			power *= 2
+			length = 0
+		}
+
+		fastNode = fastNode.Next
+		length++
+		cycle.Steps++
+	}
+
+	if fastNode == nil {
+		return cycle
+	}
+
+	cycle.Found = true
+	cycle.Length = length
+
+	slowNode, fastNode = l.head, l.head
+	for i := 0; i < length; i++ {
+		fastNode = fastNode.Next
+		cycle.Steps++
+	}
+
+	for slowNode != fastNode {
+		slowNode = slowNode.Next
+		fastNode = fastNode.Next
+		cycle.Start++
+		cycle.Steps++
+	}
+
+	return cycle
+}
+
+// findByValue and findLastWithPrev never walk more than size nodes, so an
+// injected cycle cannot keep them spinning.
+func (l *linkedList) findByValue(value string) (*Node, *Node, int) {
+	index := 0
+	currentNode := l.head
+	var prevNode *Node = nil
+	for currentNode != nil && index < l.size {
 
 		if currentNode.Value == value {
 			return currentNode, prevNode, index
@@ -273,9 +605,9 @@ func (l *linkedList) findByValue(value string) (*node, *node, int) {
 	return nil, nil, -1
 }
 
-func (l *linkedList) findByIndex(index int) (*node, *node) {
+func (l *linkedList) findByIndex(index int) (*Node, *Node) {
 	currentNode := l.head
-	var prevNode *node = nil
+	var prevNode *Node = nil
 
 	for i := 0; i < index; i++ {
 		if currentNode == nil {
@@ -288,13 +620,13 @@ func (l *linkedList) findByIndex(index int) (*node, *node) {
 	return currentNode, prevNode
 }
 
-func (l *linkedList) findLastWithPrev() (*node, *node, int) {
+func (l *linkedList) findLastWithPrev() (*Node, *Node, int) {
 	index := 0
 	currentNode := l.head
-	var prevNode *node = nil
-	for currentNode != nil {
+	var prevNode *Node = nil
+	for currentNode != nil && index < l.size {
 
-		if currentNode.Next == nil {
+		if currentNode.Next == nil || currentNode == l.tail {
 			return currentNode, prevNode, index
 		}
 
@@ -306,7 +638,9 @@ func (l *linkedList) findLastWithPrev() (*node, *node, int) {
 	return nil, nil, -1
 }
 
-func (l *linkedList) unlinkNode(currentNode *node, prevNode *node) {
+func (l *linkedList) unlinkNode(currentNode *Node, prevNode *Node) {
+	l.modCount++
+
 	if currentNode == l.head && currentNode == l.tail {
 		l.head = nil
 		l.tail = nil
@@ -330,6 +664,48 @@ func (l *linkedList) unlinkNode(currentNode *node, prevNode *node) {
 	prevNode.Next = currentNode.Next
 }
 
+func mergeSort(head *Node) *Node {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	slowNode, fastNode := head, head.Next
+	for fastNode != nil && fastNode.Next != nil {
+		slowNode = slowNode.Next
+		fastNode = fastNode.Next.Next
+	}
+
+	secondHalf := slowNode.Next
+	slowNode.Next = nil
+
+	return mergeNodes(mergeSort(head), mergeSort(secondHalf))
+}
+
+// mergeNodes takes from left on ties, which keeps the sort stable.
+func mergeNodes(left *Node, right *Node) *Node {
+	sentinel := &Node{}
+	tailNode := sentinel
+
+	for left != nil && right != nil {
+		if right.Value < left.Value {
+			tailNode.Next = right
+			right = right.Next
+		} else {
+			tailNode.Next = left
+			left = left.Next
+		}
+		tailNode = tailNode.Next
+	}
+
+	if left != nil {
+		tailNode.Next = left
+	} else {
+		tailNode.Next = right
+	}
+
+	return sentinel.Next
+}
+
 func (l *linkedList) validateEmpty() error {
 	if l.head == nil {
 		return ErrEmpty
@@ -338,6 +714,16 @@ func (l *linkedList) validateEmpty() error {
 	return nil
 }
 
+// validateAcyclic relies on CreateCycle being the only way to form a cycle,
+// and it always does so through the tail.
+func (l *linkedList) validateAcyclic() error {
+	if l.tail != nil && l.tail.Next != nil {
+		return ErrCycle
+	}
+
+	return nil
+}
+
 func (l *linkedList) validateIndex(index int, allowEqualSize bool) error {
 	if index < 0 {
 		return ErrIndexNotFound
@@ -345,7 +731,7 @@ func (l *linkedList) validateIndex(index int, allowEqualSize bool) error {
 	if allowEqualSize && index > l.size {
 		return ErrIndexNotFound
 	}
-	if index > l.size {
+	if !allowEqualSize && index >= l.size {
 		return ErrIndexNotFound
 	}
 	return nil
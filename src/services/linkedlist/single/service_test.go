@@ -0,0 +1,185 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func newListOf(values ...string) SingleLinkedListService {
+	l := NewSingleLinkedList()
+	for _, value := range values {
+		l.AddLast(value)
+	}
+
+	return l
+}
+
+func TestSortKeepsEqualValuesInOrder(t *testing.T) {
+	l := newListOf("b", "a", "c", "a", "b", "a")
+
+	// Equal values only differ by node, so stability is checked on the
+	// nodes themselves.
+	var before []*Node
+	for i := 0; i < l.Size(); i++ {
+		node, _ := l.GetAt(i)
+		before = append(before, node)
+	}
+
+	if err := l.Sort(); err != nil {
+		t.Fatalf("Sort() = %v", err)
+	}
+
+	if values := l.ToSlice(); !slices.Equal(values, []string{"a", "a", "a", "b", "b", "c"}) {
+		t.Fatalf("ToSlice() after Sort() = %q", values)
+	}
+
+	want := []*Node{before[1], before[3], before[5], before[0], before[4], before[2]}
+	for i, node := range want {
+		if got, _ := l.GetAt(i); got != node {
+			t.Fatalf("GetAt(%d) after Sort() is not the node that held %q at index %d", i, node.Value, slices.Index(before, node))
+		}
+	}
+
+	l.AddLast("z")
+	if values := l.ToSlice(); values[len(values)-1] != "z" || l.Size() != 7 {
+		t.Fatalf("AddLast() after Sort() = %q, want the tail to follow the sorted nodes", values)
+	}
+}
+
+func TestSortMatchesSlicesSort(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for size := 1; size < 40; size++ {
+		var values []string
+		for i := 0; i < size; i++ {
+			values = append(values, strconv.Itoa(r.Intn(10)))
+		}
+
+		l := newListOf(values...)
+		if err := l.Sort(); err != nil {
+			t.Fatalf("Sort() of %q = %v", values, err)
+		}
+
+		slices.Sort(values)
+		if got := l.ToSlice(); !slices.Equal(got, values) {
+			t.Fatalf("Sort() = %q, want %q", got, values)
+		}
+	}
+
+	if err := NewSingleLinkedList().Sort(); err != ErrEmpty {
+		t.Fatalf("Sort() on empty list = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []string
+		removed int
+	}{
+		{"sorted input", []string{"a", "a", "b", "b", "b", "c"}, []string{"a", "b", "c"}, 3},
+		{"unsorted input keeps first occurrences", []string{"b", "a", "b", "c", "a"}, []string{"b", "a", "c"}, 2},
+		{"duplicate tail", []string{"a", "b", "a"}, []string{"a", "b"}, 1},
+		{"single value repeated", []string{"x", "x", "x"}, []string{"x"}, 2},
+		{"no duplicates", []string{"c", "a", "b"}, []string{"c", "a", "b"}, 0},
+	}
+
+	for _, tt := range tests {
+		l := newListOf(tt.values...)
+
+		removed, err := l.Dedupe()
+		if err != nil || removed != tt.removed {
+			t.Fatalf("%s: Dedupe() = %d, %v, want %d", tt.name, removed, err, tt.removed)
+		}
+		if got := l.ToSlice(); !slices.Equal(got, tt.want) || l.Size() != len(tt.want) {
+			t.Fatalf("%s: ToSlice() after Dedupe() = %q, size %d, want %q", tt.name, got, l.Size(), tt.want)
+		}
+
+		// The tail has to be the last kept node for appends to land.
+		l.AddLast("end")
+		if got := l.ToSlice(); got[len(got)-1] != "end" || len(got) != len(tt.want)+1 {
+			t.Fatalf("%s: AddLast() after Dedupe() = %q", tt.name, got)
+		}
+	}
+
+	if _, err := NewSingleLinkedList().Dedupe(); err != ErrEmpty {
+		t.Fatalf("Dedupe() on empty list = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestDetectCycle(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		index  int
+		start  int
+		length int
+	}{
+		{"cycle in the middle", 5, 2, 2, 3},
+		{"self-loop on the tail", 5, 4, 4, 1},
+		{"cycle through the head", 5, 0, 0, 5},
+		{"single node self-loop", 1, 0, 0, 1},
+		{"two nodes through the head", 2, 0, 0, 2},
+		{"long tail before the cycle", 40, 31, 31, 9},
+	}
+
+	for _, tt := range tests {
+		l := NewSingleLinkedList()
+		for i := 0; i < tt.size; i++ {
+			l.AddLast(strconv.Itoa(i))
+		}
+
+		if err := l.CreateCycle(tt.index); err != nil {
+			t.Fatalf("%s: CreateCycle(%d) = %v", tt.name, tt.index, err)
+		}
+
+		detectors := map[string]func() Cycle{"Floyd": l.DetectCycleFloyd, "Brent": l.DetectCycleBrent}
+		for name, detect := range detectors {
+			cycle := detect()
+			if !cycle.Found || cycle.Start != tt.start || cycle.Length != tt.length {
+				t.Fatalf("%s: DetectCycle%s() = %+v, want start %d and length %d", tt.name, name, cycle, tt.start, tt.length)
+			}
+			if cycle.Steps == 0 {
+				t.Fatalf("%s: DetectCycle%s() reported no steps", tt.name, name)
+			}
+		}
+
+		if err := l.BreakCycle(); err != nil {
+			t.Fatalf("%s: BreakCycle() = %v", tt.name, err)
+		}
+		for name, detect := range detectors {
+			if cycle := detect(); cycle.Found {
+				t.Fatalf("%s: DetectCycle%s() after BreakCycle() = %+v, want none", tt.name, name, cycle)
+			}
+		}
+	}
+}
+
+func TestDetectCycleWithoutCycle(t *testing.T) {
+	for _, l := range []SingleLinkedListService{NewSingleLinkedList(), newListOf("a"), newListOf("a", "b", "c")} {
+		if cycle := l.DetectCycleFloyd(); cycle.Found {
+			t.Fatalf("DetectCycleFloyd() on %q = %+v, want none", l.ToSlice(), cycle)
+		}
+		if cycle := l.DetectCycleBrent(); cycle.Found {
+			t.Fatalf("DetectCycleBrent() on %q = %+v, want none", l.ToSlice(), cycle)
+		}
+	}
+
+	if err := newListOf("a").BreakCycle(); err != ErrNoCycle {
+		t.Fatalf("BreakCycle() without a cycle = %v, want %v", err, ErrNoCycle)
+	}
+}
+
+func TestCycleBlocksRelinking(t *testing.T) {
+	l := newListOf("b", "a", "c")
+	l.CreateCycle(1)
+
+	if err := l.Sort(); err != ErrCycle {
+		t.Fatalf("Sort() with a cycle = %v, want %v", err, ErrCycle)
+	}
+	if err := l.CreateCycle(0); err != ErrCycle {
+		t.Fatalf("CreateCycle() twice = %v, want %v", err, ErrCycle)
+	}
+}
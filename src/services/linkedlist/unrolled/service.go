@@ -0,0 +1,702 @@
+package linkedlist
+
+import (
+	"errors"
+)
+
+const (
+	DefaultCapacity = 16
+	MaxCapacity     = 1024
+)
+
+var (
+	ErrEmpty            = errors.New("unrolled linked list is empty")
+	ErrIndexNotFound    = errors.New("unrolled linked list index not found")
+	ErrNotFound         = errors.New("unrolled linked list value not found")
+	ErrInvalidCapacity  = errors.New("unrolled linked list capacity must be between 2 and 1024")
+	ErrCapacityMismatch = errors.New("unrolled linked lists must share the same node capacity")
+	ErrSameList         = errors.New("unrolled linked list cannot be joined with itself")
+	ErrForeignList      = errors.New("unrolled linked list implementation is not supported")
+)
+
+// UnrolledLinkedListService mirrors DoubleLinkedListService's insertion,
+// deletion, access and relinking methods, without Iterator, and every node
+// stores up to Capacity values in one contiguous slice. A walk touches one
+// node per Capacity values, which is where the cache locality comes from.
+// Lookups return an Element instead of a node because a value's neighbours
+// may live in another node.
+type UnrolledLinkedListService interface {
+	// Insertion Methods
+	AddFirst(value string)
+	AddLast(value string)
+	InsertAt(index int, value string) error
+	InsertAfter(searchValue string, newValue string) error
+
+	// Deletion Methods
+	RemoveFirst() (string, error)
+	RemoveLast() (string, error)
+	RemoveAt(index int) (string, error)
+	Remove(value string) (string, error)
+	Clear()
+
+	// Accessibility Methods
+	GetAt(index int) (Element, error)
+	Find(value string) (Element, error)
+	IndexOf(value string) (int, error)
+	FindLast(value string) (Element, error)
+	LastIndexOf(value string) (int, error)
+	ToSlice() []string
+	ToSliceReverse() []string
+	Size() int
+
+	// Relinking Methods
+	Concat(other UnrolledLinkedListService) error
+	SplitAt(index int) (UnrolledLinkedListService, error)
+	Splice(index int, other UnrolledLinkedListService) error
+	Sublist(from int, to int) (UnrolledLinkedListService, error)
+
+	// Configuration Methods
+	Initialize(capacity int) error
+	Stats() Stats
+}
+
+type Element struct {
+	Value string
+	Index int
+	Prev  *string
+	Next  *string
+}
+
+type Stats struct {
+	Size        int
+	Capacity    int
+	Nodes       int
+	FillFactors []float64
+	AverageFill float64
+	MinFill     float64
+	MaxFill     float64
+	// SavedNodes is how many fewer nodes the list needs than a list with
+	// one value per node.
+	SavedNodes int
+}
+
+type block struct {
+	values []string
+	next   *block
+	prev   *block
+}
+
+// Removals keep nodes at least half full by borrowing from or merging with
+// a neighbour, so a walk visits about size/capacity to 2*size/capacity
+// nodes. Appends and prepends open a fresh node instead of splitting a full
+// one, which lets sequential loads fill every node completely.
+type unrolledList struct {
+	head     *block
+	tail     *block
+	size     int
+	capacity int
+}
+
+func NewUnrolledLinkedList() UnrolledLinkedListService {
+	return &unrolledList{capacity: DefaultCapacity}
+}
+
+// Initialize implements UnrolledLinkedListService.
+// It empties the list and sets the node capacity.
+func (l *unrolledList) Initialize(capacity int) error {
+	if capacity < 2 || capacity > MaxCapacity {
+		return ErrInvalidCapacity
+	}
+
+	l.Clear()
+	l.capacity = capacity
+
+	return nil
+}
+
+// AddFirst implements UnrolledLinkedListService.
+func (l *unrolledList) AddFirst(value string) {
+	l.insert(0, value)
+}
+
+// AddLast implements UnrolledLinkedListService.
+func (l *unrolledList) AddLast(value string) {
+	l.insert(l.size, value)
+}
+
+// InsertAt implements UnrolledLinkedListService.
+// Negative indices count from the end, as in DoubleLinkedListService.
+func (l *unrolledList) InsertAt(index int, value string) error {
+	index, err := l.resolveIndex(index, true)
+	if err != nil {
+		return err
+	}
+
+	l.insert(index, value)
+
+	return nil
+}
+
+// InsertAfter implements UnrolledLinkedListService.
+func (l *unrolledList) InsertAfter(searchValue string, newValue string) error {
+	if err := l.validateEmpty(); err != nil {
+		return err
+	}
+
+	index := l.indexOf(searchValue)
+	if index < 0 {
+		return ErrNotFound
+	}
+
+	l.insert(index+1, newValue)
+
+	return nil
+}
+
+// RemoveFirst implements UnrolledLinkedListService.
+func (l *unrolledList) RemoveFirst() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return l.removeAt(0), nil
+}
+
+// RemoveLast implements UnrolledLinkedListService.
+func (l *unrolledList) RemoveLast() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	return l.removeAt(l.size - 1), nil
+}
+
+// RemoveAt implements UnrolledLinkedListService.
+func (l *unrolledList) RemoveAt(index int) (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	index, err := l.resolveIndex(index, false)
+	if err != nil {
+		return "", err
+	}
+
+	return l.removeAt(index), nil
+}
+
+// Remove implements UnrolledLinkedListService.
+func (l *unrolledList) Remove(value string) (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	index := l.indexOf(value)
+	if index < 0 {
+		return "", ErrNotFound
+	}
+
+	return l.removeAt(index), nil
+}
+
+// Clear implements UnrolledLinkedListService.
+func (l *unrolledList) Clear() {
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+}
+
+// GetAt implements UnrolledLinkedListService.
+func (l *unrolledList) GetAt(index int) (Element, error) {
+	if err := l.validateEmpty(); err != nil {
+		return Element{}, err
+	}
+
+	index, err := l.resolveIndex(index, false)
+	if err != nil {
+		return Element{}, err
+	}
+
+	return l.elementAt(index), nil
+}
+
+// Find implements UnrolledLinkedListService.
+func (l *unrolledList) Find(value string) (Element, error) {
+	if err := l.validateEmpty(); err != nil {
+		return Element{}, err
+	}
+
+	index := l.indexOf(value)
+	if index < 0 {
+		return Element{}, ErrNotFound
+	}
+
+	return l.elementAt(index), nil
+}
+
+// IndexOf implements UnrolledLinkedListService.
+func (l *unrolledList) IndexOf(value string) (int, error) {
+	if err := l.validateEmpty(); err != nil {
+		return -1, err
+	}
+
+	return l.indexOf(value), nil
+}
+
+// FindLast implements UnrolledLinkedListService.
+func (l *unrolledList) FindLast(value string) (Element, error) {
+	if err := l.validateEmpty(); err != nil {
+		return Element{}, err
+	}
+
+	index := l.lastIndexOf(value)
+	if index < 0 {
+		return Element{}, ErrNotFound
+	}
+
+	return l.elementAt(index), nil
+}
+
+// LastIndexOf implements UnrolledLinkedListService.
+func (l *unrolledList) LastIndexOf(value string) (int, error) {
+	if err := l.validateEmpty(); err != nil {
+		return -1, err
+	}
+
+	return l.lastIndexOf(value), nil
+}
+
+// ToSlice implements UnrolledLinkedListService.
+func (l *unrolledList) ToSlice() []string {
+	values := make([]string, 0, l.size)
+	for currentBlock := l.head; currentBlock != nil; currentBlock = currentBlock.next {
+		values = append(values, currentBlock.values...)
+	}
+
+	return values
+}
+
+// ToSliceReverse implements UnrolledLinkedListService.
+func (l *unrolledList) ToSliceReverse() []string {
+	values := make([]string, 0, l.size)
+	for currentBlock := l.tail; currentBlock != nil; currentBlock = currentBlock.prev {
+		for i := len(currentBlock.values) - 1; i >= 0; i-- {
+			values = append(values, currentBlock.values[i])
+		}
+	}
+
+	return values
+}
+
+// Size implements UnrolledLinkedListService.
+func (l *unrolledList) Size() int {
+	return l.size
+}
+
+// Concat implements UnrolledLinkedListService.
+// The nodes of other are moved, so it runs in O(1) and leaves other empty.
+func (l *unrolledList) Concat(other UnrolledLinkedListService) error {
+	otherList, err := l.validateOther(other)
+	if err != nil {
+		return err
+	}
+
+	l.linkAfterTail(otherList)
+
+	return nil
+}
+
+// SplitAt implements UnrolledLinkedListService.
+// The list keeps the values before index and the returned list takes the
+// rest. At most one node is cut in two; every other node is moved as is.
+func (l *unrolledList) SplitAt(index int) (UnrolledLinkedListService, error) {
+	index, err := l.resolveIndex(index, true)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := &unrolledList{capacity: l.capacity}
+	if index == l.size {
+		return suffix, nil
+	}
+
+	currentBlock, offset := l.locate(index)
+	if offset > 0 {
+		currentBlock = l.splitBlock(currentBlock, offset)
+	}
+
+	suffix.head = currentBlock
+	suffix.tail = l.tail
+	suffix.size = l.size - index
+
+	l.tail = currentBlock.prev
+	if l.tail == nil {
+		l.head = nil
+	} else {
+		l.tail.next = nil
+	}
+	currentBlock.prev = nil
+	l.size = index
+
+	l.rebalance(l.tail)
+	suffix.rebalance(suffix.head)
+
+	return suffix, nil
+}
+
+// Splice implements UnrolledLinkedListService.
+// Every value of other is moved in front of index, leaving other empty.
+func (l *unrolledList) Splice(index int, other UnrolledLinkedListService) error {
+	otherList, err := l.validateOther(other)
+	if err != nil {
+		return err
+	}
+
+	index, err = l.resolveIndex(index, true)
+	if err != nil {
+		return err
+	}
+
+	suffix, _ := l.SplitAt(index)
+	l.linkAfterTail(otherList)
+	l.linkAfterTail(suffix.(*unrolledList))
+
+	return nil
+}
+
+// Sublist implements UnrolledLinkedListService.
+// It copies the values in [from, to) into a list with the same capacity.
+func (l *unrolledList) Sublist(from int, to int) (UnrolledLinkedListService, error) {
+	from, err := l.resolveIndex(from, true)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err = l.resolveIndex(to, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if from > to {
+		return nil, ErrIndexNotFound
+	}
+
+	sublist := &unrolledList{capacity: l.capacity}
+	if from == to {
+		return sublist, nil
+	}
+
+	currentBlock, offset := l.locate(from)
+	for copied := 0; copied < to-from; currentBlock, offset = currentBlock.next, 0 {
+		take := min(len(currentBlock.values)-offset, to-from-copied)
+		for _, value := range currentBlock.values[offset : offset+take] {
+			sublist.AddLast(value)
+		}
+		copied += take
+	}
+
+	return sublist, nil
+}
+
+// Stats implements UnrolledLinkedListService.
+func (l *unrolledList) Stats() Stats {
+	stats := Stats{
+		Size:        l.size,
+		Capacity:    l.capacity,
+		FillFactors: []float64{},
+	}
+
+	if l.head == nil {
+		return stats
+	}
+
+	stats.MinFill = 1
+	for currentBlock := l.head; currentBlock != nil; currentBlock = currentBlock.next {
+		fill := float64(len(currentBlock.values)) / float64(l.capacity)
+
+		stats.Nodes++
+		stats.FillFactors = append(stats.FillFactors, fill)
+		stats.MinFill = min(stats.MinFill, fill)
+		stats.MaxFill = max(stats.MaxFill, fill)
+	}
+
+	stats.AverageFill = float64(l.size) / float64(stats.Nodes*l.capacity)
+	stats.SavedNodes = l.size - stats.Nodes
+
+	return stats
+}
+
+/* Private Methods */
+
+// insert places value at index. A full node is split in half, except at
+// either end of the list where the value gets a fresh node of its own.
+func (l *unrolledList) insert(index int, value string) {
+	if l.head == nil {
+		l.head = l.newBlock()
+		l.tail = l.head
+	}
+
+	var currentBlock *block
+	var offset int
+	if index == l.size {
+		currentBlock, offset = l.tail, len(l.tail.values)
+	} else {
+		currentBlock, offset = l.locate(index)
+	}
+
+	if len(currentBlock.values) == l.capacity {
+		splitAt := l.capacity / 2
+		if index == l.size {
+			splitAt = l.capacity
+		} else if index == 0 {
+			splitAt = 0
+		}
+
+		nextBlock := l.splitBlock(currentBlock, splitAt)
+
+		if offset > splitAt || offset == l.capacity {
+			currentBlock, offset = nextBlock, offset-splitAt
+		}
+	}
+
+	currentBlock.values = append(currentBlock.values, "")
+	copy(currentBlock.values[offset+1:], currentBlock.values[offset:])
+	currentBlock.values[offset] = value
+
+	l.size++
+}
+
+func (l *unrolledList) removeAt(index int) string {
+	currentBlock, offset := l.locate(index)
+
+	removedValue := currentBlock.values[offset]
+	currentBlock.values = append(currentBlock.values[:offset], currentBlock.values[offset+1:]...)
+
+	l.size--
+	l.rebalance(currentBlock)
+
+	return removedValue
+}
+
+// rebalance restores the half full invariant for currentBlock after a
+// removal: an empty node is unlinked, and a node under half full borrows a
+// value from a neighbour or, when both fit in one node, merges with it.
+func (l *unrolledList) rebalance(currentBlock *block) {
+	if currentBlock == nil {
+		return
+	}
+
+	if len(currentBlock.values) == 0 {
+		l.unlinkBlock(currentBlock)
+		return
+	}
+
+	if len(currentBlock.values) >= l.capacity/2 {
+		return
+	}
+
+	if nextBlock := currentBlock.next; nextBlock != nil {
+		if len(currentBlock.values)+len(nextBlock.values) <= l.capacity {
+			currentBlock.values = append(currentBlock.values, nextBlock.values...)
+			l.unlinkBlock(nextBlock)
+			return
+		}
+
+		currentBlock.values = append(currentBlock.values, nextBlock.values[0])
+		nextBlock.values = append(nextBlock.values[:0], nextBlock.values[1:]...)
+		return
+	}
+
+	if prevBlock := currentBlock.prev; prevBlock != nil {
+		if len(prevBlock.values)+len(currentBlock.values) <= l.capacity {
+			prevBlock.values = append(prevBlock.values, currentBlock.values...)
+			l.unlinkBlock(currentBlock)
+			return
+		}
+
+		last := len(prevBlock.values) - 1
+		currentBlock.values = append(currentBlock.values, "")
+		copy(currentBlock.values[1:], currentBlock.values)
+		currentBlock.values[0] = prevBlock.values[last]
+		prevBlock.values = prevBlock.values[:last]
+	}
+}
+
+// locate returns the node holding index and the offset inside it, walking
+// from whichever end is closer.
+func (l *unrolledList) locate(index int) (*block, int) {
+	if index < l.size/2 {
+		currentBlock := l.head
+		for index >= len(currentBlock.values) {
+			index -= len(currentBlock.values)
+			currentBlock = currentBlock.next
+		}
+
+		return currentBlock, index
+	}
+
+	remaining := l.size - index
+	currentBlock := l.tail
+	for remaining > len(currentBlock.values) {
+		remaining -= len(currentBlock.values)
+		currentBlock = currentBlock.prev
+	}
+
+	return currentBlock, len(currentBlock.values) - remaining
+}
+
+func (l *unrolledList) elementAt(index int) Element {
+	currentBlock, offset := l.locate(index)
+	element := Element{Value: currentBlock.values[offset], Index: index}
+
+	// Neighbours are copied so the element does not alias node storage.
+	if offset > 0 {
+		prev := currentBlock.values[offset-1]
+		element.Prev = &prev
+	} else if currentBlock.prev != nil {
+		prev := currentBlock.prev.values[len(currentBlock.prev.values)-1]
+		element.Prev = &prev
+	}
+
+	if offset < len(currentBlock.values)-1 {
+		next := currentBlock.values[offset+1]
+		element.Next = &next
+	} else if currentBlock.next != nil {
+		next := currentBlock.next.values[0]
+		element.Next = &next
+	}
+
+	return element
+}
+
+func (l *unrolledList) indexOf(value string) int {
+	index := 0
+	for currentBlock := l.head; currentBlock != nil; currentBlock = currentBlock.next {
+		for _, currentValue := range currentBlock.values {
+			if currentValue == value {
+				return index
+			}
+			index++
+		}
+	}
+
+	return -1
+}
+
+func (l *unrolledList) lastIndexOf(value string) int {
+	index := l.size - 1
+	for currentBlock := l.tail; currentBlock != nil; currentBlock = currentBlock.prev {
+		for i := len(currentBlock.values) - 1; i >= 0; i-- {
+			if currentBlock.values[i] == value {
+				return index
+			}
+			index--
+		}
+	}
+
+	return -1
+}
+
+// splitBlock moves the values from offset onwards into a new node linked
+// right after currentBlock and returns it.
+func (l *unrolledList) splitBlock(currentBlock *block, offset int) *block {
+	nextBlock := l.newBlock()
+	nextBlock.values = append(nextBlock.values, currentBlock.values[offset:]...)
+	currentBlock.values = currentBlock.values[:offset]
+
+	nextBlock.prev = currentBlock
+	nextBlock.next = currentBlock.next
+	if currentBlock.next == nil {
+		l.tail = nextBlock
+	} else {
+		currentBlock.next.prev = nextBlock
+	}
+	currentBlock.next = nextBlock
+
+	return nextBlock
+}
+
+func (l *unrolledList) unlinkBlock(currentBlock *block) {
+	if currentBlock.prev == nil {
+		l.head = currentBlock.next
+	} else {
+		currentBlock.prev.next = currentBlock.next
+	}
+
+	if currentBlock.next == nil {
+		l.tail = currentBlock.prev
+	} else {
+		currentBlock.next.prev = currentBlock.prev
+	}
+}
+
+// linkAfterTail moves every node of other to the end of the list and leaves
+// other empty. The two nodes meeting at the join are merged when they fit
+// in one.
+func (l *unrolledList) linkAfterTail(other *unrolledList) {
+	if other.head == nil {
+		return
+	}
+
+	joint := l.tail
+	if joint == nil {
+		l.head = other.head
+	} else {
+		joint.next = other.head
+		other.head.prev = joint
+	}
+	l.tail = other.tail
+
+	l.size += other.size
+	other.Clear()
+
+	if joint != nil && len(joint.values)+len(joint.next.values) <= l.capacity {
+		joint.values = append(joint.values, joint.next.values...)
+		l.unlinkBlock(joint.next)
+	}
+}
+
+func (l *unrolledList) newBlock() *block {
+	return &block{values: make([]string, 0, l.capacity)}
+}
+
+/* Validations */
+
+func (l *unrolledList) validateEmpty() error {
+	if l.size == 0 {
+		return ErrEmpty
+	}
+
+	return nil
+}
+
+// resolveIndex turns a negative index into its offset from the start and
+// validates the result.
+func (l *unrolledList) resolveIndex(index int, allowEqualSize bool) (int, error) {
+	if index < 0 {
+		index += l.size
+	}
+
+	if index < 0 || index > l.size || (!allowEqualSize && index == l.size) {
+		return -1, ErrIndexNotFound
+	}
+
+	return index, nil
+}
+
+func (l *unrolledList) validateOther(other UnrolledLinkedListService) (*unrolledList, error) {
+	otherList, ok := other.(*unrolledList)
+	if !ok {
+		return nil, ErrForeignList
+	}
+
+	if otherList == l {
+		return nil, ErrSameList
+	}
+
+	if otherList.capacity != l.capacity {
+		return nil, ErrCapacityMismatch
+	}
+
+	return otherList, nil
+}
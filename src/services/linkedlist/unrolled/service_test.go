@@ -0,0 +1,182 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+
+	double "golabs/src/services/linkedlist/double"
+)
+
+func TestUnrolledLinkedListMatchesSliceModel(t *testing.T) {
+	for _, capacity := range []int{2, 3, 4, 16} {
+		list := NewUnrolledLinkedList()
+		if err := list.Initialize(capacity); err != nil {
+			t.Fatalf("Initialize(%d) = %v", capacity, err)
+		}
+		var model []string
+
+		r := rand.New(rand.NewSource(int64(capacity)))
+		for i := 0; i < 5000; i++ {
+			value := strconv.Itoa(i)
+
+			switch r.Intn(6) {
+			case 0:
+				list.AddFirst(value)
+				model = slices.Insert(model, 0, value)
+			case 1:
+				list.AddLast(value)
+				model = append(model, value)
+			case 2, 3:
+				index := r.Intn(len(model) + 1)
+				if err := list.InsertAt(index, value); err != nil {
+					t.Fatalf("capacity %d: InsertAt(%d) = %v", capacity, index, err)
+				}
+				model = slices.Insert(model, index, value)
+			case 4, 5:
+				if len(model) == 0 {
+					if _, err := list.RemoveAt(0); err != ErrEmpty {
+						t.Fatalf("capacity %d: RemoveAt(0) on empty list = %v, want %v", capacity, err, ErrEmpty)
+					}
+					continue
+				}
+				index := r.Intn(len(model))
+				removed, err := list.RemoveAt(index)
+				if err != nil || removed != model[index] {
+					t.Fatalf("capacity %d: RemoveAt(%d) = %q, %v, want %q", capacity, index, removed, err, model[index])
+				}
+				model = slices.Delete(model, index, index+1)
+			}
+
+			assertUnrolledList(t, list, model)
+		}
+	}
+}
+
+func TestUnrolledLinkedListRelinkingMatchesSliceModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 200; round++ {
+		left, leftModel := randomUnrolledList(r, 4)
+		right, rightModel := randomUnrolledList(r, 4)
+
+		switch r.Intn(3) {
+		case 0:
+			if err := left.Concat(right); err != nil {
+				t.Fatalf("Concat() = %v", err)
+			}
+			leftModel = append(leftModel, rightModel...)
+			rightModel = nil
+		case 1:
+			index := r.Intn(len(leftModel) + 1)
+			if err := left.Splice(index, right); err != nil {
+				t.Fatalf("Splice(%d) = %v", index, err)
+			}
+			leftModel = slices.Insert(leftModel, index, rightModel...)
+			rightModel = nil
+		case 2:
+			if len(leftModel) == 0 {
+				continue
+			}
+			index := r.Intn(len(leftModel))
+			suffix, err := left.SplitAt(index)
+			if err != nil {
+				t.Fatalf("SplitAt(%d) = %v", index, err)
+			}
+			right = suffix
+			rightModel = slices.Clone(leftModel[index:])
+			leftModel = leftModel[:index]
+		}
+
+		assertUnrolledList(t, left, leftModel)
+		assertUnrolledList(t, right, rightModel)
+
+		from := r.Intn(len(leftModel) + 1)
+		to := from + r.Intn(len(leftModel)-from+1)
+		sublist, err := left.Sublist(from, to)
+		if err != nil {
+			t.Fatalf("Sublist(%d, %d) = %v", from, to, err)
+		}
+		assertUnrolledList(t, sublist, leftModel[from:to])
+	}
+}
+
+// Iteration walks one node per Capacity values, so ToSlice should beat the
+// double list's node-per-value walk. Indexed access skips whole nodes by
+// their length instead of stepping value by value.
+func BenchmarkUnrolledVsDoubleLinkedList(b *testing.B) {
+	const size = 100000
+
+	unrolled := NewUnrolledLinkedList()
+	linked := double.NewDoubleLinkedList()
+	for i := 0; i < size; i++ {
+		unrolled.AddLast(strconv.Itoa(i))
+		linked.AddLast(strconv.Itoa(i))
+	}
+
+	b.Run("Iterate/Unrolled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			unrolled.ToSlice()
+		}
+	})
+	b.Run("Iterate/Double", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			linked.ToSlice()
+		}
+	})
+
+	b.Run("GetAt/Unrolled", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			unrolled.GetAt(r.Intn(size))
+		}
+	})
+	b.Run("GetAt/Double", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			linked.GetAt(r.Intn(size))
+		}
+	})
+}
+
+func randomUnrolledList(r *rand.Rand, capacity int) (UnrolledLinkedListService, []string) {
+	list := NewUnrolledLinkedList()
+	list.Initialize(capacity)
+
+	var model []string
+	for i := r.Intn(20); i > 0; i-- {
+		value := strconv.Itoa(r.Int())
+		index := r.Intn(len(model) + 1)
+		list.InsertAt(index, value)
+		model = slices.Insert(model, index, value)
+	}
+
+	return list, model
+}
+
+func assertUnrolledList(t *testing.T, list UnrolledLinkedListService, model []string) {
+	t.Helper()
+
+	if list.Size() != len(model) {
+		t.Fatalf("Size() = %d, want %d", list.Size(), len(model))
+	}
+
+	if forward := list.ToSlice(); !slices.Equal(forward, model) && len(forward)+len(model) > 0 {
+		t.Fatalf("ToSlice() = %v, want %v", forward, model)
+	}
+
+	backward := list.ToSliceReverse()
+	slices.Reverse(backward)
+	if !slices.Equal(backward, model) && len(backward)+len(model) > 0 {
+		t.Fatalf("ToSliceReverse() reversed = %v, want %v", backward, model)
+	}
+
+	stats := list.Stats()
+	if len(model) > 0 && stats.MinFill == 0 {
+		t.Fatalf("Stats() = %+v, an empty node was left linked", stats)
+	}
+	if stats.Nodes > len(model) {
+		t.Fatalf("Stats() = %+v, more nodes than values", stats)
+	}
+}
@@ -0,0 +1,215 @@
+package linkedlist
+
+import (
+	"errors"
+)
+
+var (
+	ErrEmpty = errors.New("xor linked list is empty")
+)
+
+type XorLinkedListService interface {
+	// Insertion Methods
+	AddFirst(value string)
+	AddLast(value string)
+
+	// Deletion Methods
+	RemoveFirst() (string, error)
+	RemoveLast() (string, error)
+	Clear()
+
+	// Accessibility Methods
+	ToSlice() []string
+	ToSliceReverse() []string
+	Size() int
+	Stats() Stats
+}
+
+type Stats struct {
+	Size       int
+	ArenaSlots int
+	FreeSlots  int
+}
+
+// address is the position of a node in the arena. Slot 0 is never used, so
+// the zero address plays the role of nil.
+type address uintptr
+
+// node keeps a single link field holding prev XOR next. Knowing either
+// neighbour is enough to recover the other one.
+type node struct {
+	Value string
+	link  address
+}
+
+// xorLinkedList stores its nodes in an arena slice and links them by arena
+// address instead of by pointer. Hiding real pointers inside a uintptr would
+// make the nodes invisible to the garbage collector, and converting such a
+// uintptr back is not allowed by the unsafe.Pointer rules; addresses into a
+// slice the list owns keep every node reachable while the XOR arithmetic
+// stays the same. Freed slots are reused before the arena grows.
+type xorLinkedList struct {
+	arena []node
+	free  []address
+	head  address
+	tail  address
+	size  int
+}
+
+func NewXorLinkedList() XorLinkedListService {
+	return &xorLinkedList{arena: make([]node, 1)}
+}
+
+// AddFirst implements XorLinkedListService.
+func (l *xorLinkedList) AddFirst(value string) {
+	l.head, l.tail = l.push(l.head, l.tail, value)
+}
+
+// AddLast implements XorLinkedListService.
+// It is AddFirst seen from the other end: swapping head and tail reverses
+// the list without touching a single link.
+func (l *xorLinkedList) AddLast(value string) {
+	l.tail, l.head = l.push(l.tail, l.head, value)
+}
+
+// RemoveFirst implements XorLinkedListService.
+func (l *xorLinkedList) RemoveFirst() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	var removedValue string
+	removedValue, l.head, l.tail = l.pop(l.head, l.tail)
+
+	return removedValue, nil
+}
+
+// RemoveLast implements XorLinkedListService.
+func (l *xorLinkedList) RemoveLast() (string, error) {
+	if err := l.validateEmpty(); err != nil {
+		return "", err
+	}
+
+	var removedValue string
+	removedValue, l.tail, l.head = l.pop(l.tail, l.head)
+
+	return removedValue, nil
+}
+
+// Clear implements XorLinkedListService.
+func (l *xorLinkedList) Clear() {
+	l.arena = make([]node, 1)
+	l.free = nil
+	l.head = 0
+	l.tail = 0
+	l.size = 0
+}
+
+// ToSlice implements XorLinkedListService.
+func (l *xorLinkedList) ToSlice() []string {
+	return l.walk(l.head)
+}
+
+// ToSliceReverse implements XorLinkedListService.
+func (l *xorLinkedList) ToSliceReverse() []string {
+	return l.walk(l.tail)
+}
+
+// Size implements XorLinkedListService.
+func (l *xorLinkedList) Size() int {
+	return l.size
+}
+
+// Stats implements XorLinkedListService.
+func (l *xorLinkedList) Stats() Stats {
+	return Stats{
+		Size:       l.size,
+		ArenaSlots: len(l.arena) - 1,
+		FreeSlots:  len(l.free),
+	}
+}
+
+/* Private Methods */
+
+// push adds value before end and returns the new end together with the
+// opposite end, which only changes when the list was empty.
+func (l *xorLinkedList) push(end address, other address, value string) (address, address) {
+	newAddress := l.alloc(value)
+	l.arena[newAddress].link = end
+
+	if end == 0 {
+		other = newAddress
+	} else {
+		// end's outer neighbour was 0, so XOR-ing in the new address turns
+		// 0 ^ inner into newAddress ^ inner.
+		l.arena[end].link ^= newAddress
+	}
+
+	l.size++
+
+	return newAddress, other
+}
+
+// pop removes the node at end and returns its value with the new end and
+// opposite end.
+func (l *xorLinkedList) pop(end address, other address) (string, address, address) {
+	removedValue := l.arena[end].Value
+	inner := l.arena[end].link
+
+	if inner == 0 {
+		other = 0
+	} else {
+		l.arena[inner].link ^= end
+	}
+
+	l.release(end)
+	l.size--
+
+	return removedValue, inner, other
+}
+
+// walk starts at one end and follows the links to the other. The address
+// of the previous node is all it needs to decode the next one.
+func (l *xorLinkedList) walk(start address) []string {
+	values := make([]string, 0, l.size)
+
+	var prevAddress address
+	for currentAddress := start; currentAddress != 0; {
+		values = append(values, l.arena[currentAddress].Value)
+
+		nextAddress := l.arena[currentAddress].link ^ prevAddress
+		prevAddress, currentAddress = currentAddress, nextAddress
+	}
+
+	return values
+}
+
+func (l *xorLinkedList) alloc(value string) address {
+	if last := len(l.free) - 1; last >= 0 {
+		reused := l.free[last]
+		l.free = l.free[:last]
+		l.arena[reused] = node{Value: value}
+
+		return reused
+	}
+
+	l.arena = append(l.arena, node{Value: value})
+
+	return address(len(l.arena) - 1)
+}
+
+// release empties the slot so the arena does not keep the value alive.
+func (l *xorLinkedList) release(freed address) {
+	l.arena[freed] = node{}
+	l.free = append(l.free, freed)
+}
+
+/* Validations */
+
+func (l *xorLinkedList) validateEmpty() error {
+	if l.head == 0 {
+		return ErrEmpty
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestXorLinkedListMatchesSliceModel(t *testing.T) {
+	list := NewXorLinkedList()
+	var model []string
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		value := strconv.Itoa(i)
+
+		switch r.Intn(4) {
+		case 0:
+			list.AddFirst(value)
+			model = slices.Insert(model, 0, value)
+		case 1:
+			list.AddLast(value)
+			model = append(model, value)
+		case 2:
+			removed, err := list.RemoveFirst()
+			if len(model) == 0 {
+				if err != ErrEmpty {
+					t.Fatalf("RemoveFirst() on empty list = %v, want %v", err, ErrEmpty)
+				}
+				continue
+			}
+			if err != nil || removed != model[0] {
+				t.Fatalf("RemoveFirst() = %q, %v, want %q", removed, err, model[0])
+			}
+			model = model[1:]
+		case 3:
+			removed, err := list.RemoveLast()
+			if len(model) == 0 {
+				if err != ErrEmpty {
+					t.Fatalf("RemoveLast() on empty list = %v, want %v", err, ErrEmpty)
+				}
+				continue
+			}
+			if err != nil || removed != model[len(model)-1] {
+				t.Fatalf("RemoveLast() = %q, %v, want %q", removed, err, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		}
+
+		assertXorList(t, list, model)
+	}
+}
+
+func TestXorLinkedListReusesFreedSlots(t *testing.T) {
+	list := NewXorLinkedList()
+
+	for i := 0; i < 100; i++ {
+		list.AddLast(strconv.Itoa(i))
+	}
+	for i := 0; i < 60; i++ {
+		list.RemoveFirst()
+	}
+	for i := 0; i < 60; i++ {
+		list.AddFirst(strconv.Itoa(i))
+	}
+
+	stats := list.Stats()
+	if stats.ArenaSlots != 100 || stats.FreeSlots != 0 || stats.Size != 100 {
+		t.Fatalf("Stats() = %+v, want 100 slots, none free", stats)
+	}
+}
+
+func TestXorLinkedListArenaStaysFlatAcrossCycles(t *testing.T) {
+	list := NewXorLinkedList()
+
+	// Every cycle fills the list to 50 from alternating ends and drains it
+	// from the other, so after the first one the arena never has to grow.
+	for cycle := 0; cycle < 200; cycle++ {
+		for i := 0; i < 50; i++ {
+			if (cycle+i)%2 == 0 {
+				list.AddFirst(strconv.Itoa(i))
+			} else {
+				list.AddLast(strconv.Itoa(i))
+			}
+		}
+
+		if stats := list.Stats(); stats.ArenaSlots != 50 || stats.FreeSlots != 0 {
+			t.Fatalf("cycle %d: Stats() when full = %+v, want 50 slots, none free", cycle, stats)
+		}
+
+		for i := 0; i < 50; i++ {
+			if cycle%2 == 0 {
+				list.RemoveLast()
+			} else {
+				list.RemoveFirst()
+			}
+		}
+
+		if stats := list.Stats(); stats.ArenaSlots != 50 || stats.FreeSlots != 50 || stats.Size != 0 {
+			t.Fatalf("cycle %d: Stats() when drained = %+v, want 50 slots, all free", cycle, stats)
+		}
+	}
+
+	// Released slots are zeroed, so the arena holds on to no old values.
+	arena := list.(*xorLinkedList).arena
+	for slot, freed := range arena {
+		if freed != (node{}) {
+			t.Fatalf("arena[%d] = %+v after draining, want an empty slot", slot, freed)
+		}
+	}
+}
+
+func assertXorList(t *testing.T, list XorLinkedListService, model []string) {
+	t.Helper()
+
+	if list.Size() != len(model) {
+		t.Fatalf("Size() = %d, want %d", list.Size(), len(model))
+	}
+
+	if forward := list.ToSlice(); !slices.Equal(forward, model) {
+		t.Fatalf("ToSlice() = %v, want %v", forward, model)
+	}
+
+	backward := list.ToSliceReverse()
+	slices.Reverse(backward)
+	if !slices.Equal(backward, model) {
+		t.Fatalf("ToSliceReverse() reversed = %v, want %v", backward, model)
+	}
+}
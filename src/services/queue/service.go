@@ -1,61 +1,110 @@
 package queue
 
-import "errors"
+import (
+	"errors"
+
+	"golabs/src/services/ring"
+)
 
 var (
 	ErrUninitialized = errors.New("queue is not initialized")
 	ErrEmpty         = errors.New("queue is empty")
 	ErrFull          = errors.New("queue is full")
+	ErrIndexNotFound = errors.New("queue index not found")
+	ErrInvalidMode   = errors.New("queue mode is invalid")
+	ErrInvalidCount  = errors.New("queue count must not be negative")
+)
+
+type Mode string
+
+const (
+	// ModeBounded rejects an Enqueue on a full queue with ErrFull.
+	ModeBounded Mode = "bounded"
+	// ModeOverwrite makes a full queue a ring buffer of the most recent
+	// values: Enqueue evicts the oldest element to make room.
+	ModeOverwrite Mode = "overwrite"
 )
 
 type QueueService interface {
-	Initialize(capacity int)
-	Enqueue(value string) error
+	Initialize(capacity int, mode Mode) error
+	Enqueue(value string) (evicted string, overwritten bool, err error)
 	Dequeue() (string, error)
 	Tail() (string, error)
 	Head() (string, error)
 	Size() (int, error)
 	IsEmpty() (bool, error)
+	Contents() ([]string, error)
+	PeekAt(index int) (string, error)
+	Latest(n int) ([]string, error)
+	Mode() Mode
+
+	// RemoveTail and RestoreHead take back an Enqueue and a Dequeue; they
+	// exist for the undo history, not as regular queue operations.
+	RemoveTail() (string, error)
+	RestoreHead(value string) error
 }
 
+// queue is a ring buffer: head is the slot of the oldest element and the
+// element i places behind it lives at (head + i) % capacity. Keeping size
+// instead of a tail index means an empty and a full queue never look alike.
 type queue struct {
 	elements []string
 	head     int
-	tail     int
 	size     int
 	capacity int
+	mode     Mode
 }
 
 func NewQueueService() QueueService {
 	return &queue{}
 }
 
-func (q *queue) Initialize(capacity int) {
+func (q *queue) Initialize(capacity int, mode Mode) error {
+	if mode == "" {
+		mode = ModeBounded
+	}
+
+	if mode != ModeBounded && mode != ModeOverwrite {
+		return ErrInvalidMode
+	}
+
 	if capacity <= 0 {
 		capacity = 10
 	}
 
 	q.elements = make([]string, capacity)
-	q.head = -1
-	q.tail = -1
+	q.head = 0
 	q.size = 0
 	q.capacity = capacity
+	q.mode = mode
+
+	return nil
 }
 
-func (q *queue) Enqueue(value string) error {
-	if err := q.validateFullQueue(); err != nil {
-		return err
+// Enqueue reports the value it pushed out when an overwrite queue was full.
+// The new value takes the evicted one's slot and the head moves on to the
+// next oldest.
+func (q *queue) Enqueue(value string) (evicted string, overwritten bool, err error) {
+	if err := q.validateInitialized(); err != nil {
+		return "", false, err
 	}
 
-	if q.tail == q.capacity-1 {
-		q.tail = -1
+	if q.size == q.capacity && q.mode == ModeOverwrite {
+		evicted = q.elements[q.head]
+		q.elements[q.head] = value
+		q.head = q.slot(1)
+
+		return evicted, true, nil
 	}
-	q.size++
-	q.tail++
 
-	q.elements[q.tail] = value
+	if err := q.validateFullQueue(); err != nil {
+		return "", false, err
+	}
 
-	return nil
+	q.elements[q.slot(q.size)] = value
+	q.size++
+
+	return "", false, nil
 }
 
 func (q *queue) Dequeue() (string, error) {
@@ -63,25 +112,47 @@ func (q *queue) Dequeue() (string, error) {
 		return "", err
 	}
 
-	if q.head == q.capacity-1 {
-		q.head = -1
-	}
+	value := q.elements[q.head]
+	q.elements[q.head] = ""
 
+	q.head = q.slot(1)
 	q.size--
-	q.head++
 
-	value := q.elements[q.head]
-	q.elements[q.head] = ""
+	return value, nil
+}
+
+func (q *queue) RemoveTail() (string, error) {
+	if err := q.validateEmptyQueue(); err != nil {
+		return "", err
+	}
+
+	tail := q.slot(q.size - 1)
+	value := q.elements[tail]
+	q.elements[tail] = ""
+
+	q.size--
 
 	return value, nil
 }
 
+func (q *queue) RestoreHead(value string) error {
+	if err := q.validateFullQueue(); err != nil {
+		return err
+	}
+
+	q.head = q.slot(q.capacity - 1)
+	q.elements[q.head] = value
+	q.size++
+
+	return nil
+}
+
 func (q *queue) Tail() (string, error) {
 	if err := q.validateEmptyQueue(); err != nil {
 		return "", err
 	}
 
-	return q.elements[q.tail], nil
+	return q.elements[q.slot(q.size-1)], nil
 }
 
 func (q *queue) Head() (string, error) {
@@ -89,7 +160,7 @@ func (q *queue) Head() (string, error) {
 		return "", err
 	}
 
-	return q.elements[q.head+1], nil
+	return q.elements[q.head], nil
 }
 
 func (q *queue) Size() (int, error) {
@@ -101,13 +172,77 @@ func (q *queue) Size() (int, error) {
 }
 
 func (q *queue) IsEmpty() (bool, error) {
-	if err := q.validateEmptyQueue(); err != nil {
+	if err := q.validateInitialized(); err != nil {
 		return true, err
 	}
 
-	return false, nil
+	return q.size == 0, nil
+}
+
+// Contents returns the elements in FIFO order, oldest first.
+func (q *queue) Contents() ([]string, error) {
+	if err := q.validateInitialized(); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, q.size)
+	for i := range values {
+		values[i] = q.elements[q.slot(i)]
+	}
+
+	return values, nil
 }
 
+// PeekAt returns the element index places behind the head without removing
+// it.
+func (q *queue) PeekAt(index int) (string, error) {
+	if err := q.validateInitialized(); err != nil {
+		return "", err
+	}
+
+	if index < 0 || index >= q.size {
+		return "", ErrIndexNotFound
+	}
+
+	return q.elements[q.slot(index)], nil
+}
+
+// Latest returns the n most recently enqueued elements, oldest first. It
+// returns the whole queue when n is larger than the size.
+func (q *queue) Latest(n int) ([]string, error) {
+	if err := q.validateInitialized(); err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, ErrInvalidCount
+	}
+
+	if n > q.size {
+		n = q.size
+	}
+
+	values := make([]string, n)
+	for i := range values {
+		values[i] = q.elements[q.slot(q.size-n+i)]
+	}
+
+	return values, nil
+}
+
+func (q *queue) Mode() Mode {
+	return q.mode
+}
+
+/* Private Methods */
+
+// slot maps an offset from the head, in [0, capacity], onto the ring.
+func (q *queue) slot(offset int) int {
+	return ring.Slot(q.head, offset, q.capacity)
+}
+
+/* Validations */
+
 func (q *queue) validateInitialized() error {
 	if q.elements == nil || q.capacity == 0 {
 		return ErrUninitialized
@@ -0,0 +1,271 @@
+package queue
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"testing/quick"
+)
+
+// model is the reference the ring buffer is checked against: a plain slice
+// with the oldest element first.
+type model struct {
+	values   []string
+	capacity int
+	mode     Mode
+}
+
+// run applies ops to a fresh queue and to the model, one op per byte, and
+// reports the first step where they disagree. The low two bits pick the
+// operation and the rest feed PeekAt, so quick explores all of them.
+func run(t *testing.T, capacity int, mode Mode, ops []byte) bool {
+	t.Helper()
+
+	q := NewQueueService()
+	q.Initialize(capacity, mode)
+	m := model{capacity: capacity, mode: mode}
+
+	for step, op := range ops {
+		value := strconv.Itoa(step)
+
+		switch op % 4 {
+		case 0, 1:
+			evicted, overwritten, err := q.Enqueue(value)
+			if len(m.values) == m.capacity && m.mode == ModeBounded {
+				if err != ErrFull {
+					t.Logf("step %d: Enqueue() on full queue = %v, want %v", step, err, ErrFull)
+					return false
+				}
+				continue
+			}
+			if len(m.values) == m.capacity {
+				if err != nil || !overwritten || evicted != m.values[0] {
+					t.Logf("step %d: Enqueue() on full queue = %q, %v, %v, want %q, true, nil", step, evicted, overwritten, err, m.values[0])
+					return false
+				}
+				m.values = m.values[1:]
+			} else if err != nil || overwritten {
+				t.Logf("step %d: Enqueue() = %q, %v, %v, want no eviction", step, evicted, overwritten, err)
+				return false
+			}
+			m.values = append(m.values, value)
+		case 2:
+			removed, err := q.Dequeue()
+			if len(m.values) == 0 {
+				if err != ErrEmpty {
+					t.Logf("step %d: Dequeue() on empty queue = %v, want %v", step, err, ErrEmpty)
+					return false
+				}
+				continue
+			}
+			if err != nil || removed != m.values[0] {
+				t.Logf("step %d: Dequeue() = %q, %v, want %q", step, removed, err, m.values[0])
+				return false
+			}
+			m.values = m.values[1:]
+		case 3:
+			index := int(op/4) % (m.capacity + 1)
+			got, err := q.PeekAt(index)
+			if index >= len(m.values) {
+				if err != ErrIndexNotFound {
+					t.Logf("step %d: PeekAt(%d) past the tail = %v, want %v", step, index, err, ErrIndexNotFound)
+					return false
+				}
+				continue
+			}
+			if err != nil || got != m.values[index] {
+				t.Logf("step %d: PeekAt(%d) = %q, %v, want %q", step, index, got, err, m.values[index])
+				return false
+			}
+		}
+
+		if !matches(t, step, q, m) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matches(t *testing.T, step int, q QueueService, m model) bool {
+	t.Helper()
+
+	contents, err := q.Contents()
+	if err != nil || !slices.Equal(contents, m.values) && len(contents)+len(m.values) > 0 {
+		t.Logf("step %d: Contents() = %q, %v, want %q", step, contents, err, m.values)
+		return false
+	}
+
+	size, err := q.Size()
+	if err != nil || size != len(m.values) {
+		t.Logf("step %d: Size() = %d, %v, want %d", step, size, err, len(m.values))
+		return false
+	}
+
+	empty, err := q.IsEmpty()
+	if err != nil || empty != (len(m.values) == 0) {
+		t.Logf("step %d: IsEmpty() = %v, %v, want %v", step, empty, err, len(m.values) == 0)
+		return false
+	}
+
+	n := step % (m.capacity + 2)
+	latest, err := q.Latest(n)
+	want := m.values[max(len(m.values)-n, 0):]
+	if err != nil || !slices.Equal(latest, want) && len(latest)+len(want) > 0 {
+		t.Logf("step %d: Latest(%d) = %q, %v, want %q", step, n, latest, err, want)
+		return false
+	}
+
+	head, headErr := q.Head()
+	tail, tailErr := q.Tail()
+	if len(m.values) == 0 {
+		if headErr != ErrEmpty || tailErr != ErrEmpty {
+			t.Logf("step %d: Head(), Tail() on empty queue = %v, %v, want %v", step, headErr, tailErr, ErrEmpty)
+			return false
+		}
+		return true
+	}
+
+	if headErr != nil || head != m.values[0] {
+		t.Logf("step %d: Head() = %q, %v, want %q", step, head, headErr, m.values[0])
+		return false
+	}
+	if tailErr != nil || tail != m.values[len(m.values)-1] {
+		t.Logf("step %d: Tail() = %q, %v, want %q", step, tail, tailErr, m.values[len(m.values)-1])
+		return false
+	}
+
+	return true
+}
+
+func TestQueueMatchesSliceModel(t *testing.T) {
+	property := func(capacity uint8, ops []byte) bool {
+		return run(t, int(capacity%8)+1, ModeBounded, ops)
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOverwriteQueueMatchesSliceModel(t *testing.T) {
+	property := func(capacity uint8, ops []byte) bool {
+		return run(t, int(capacity%8)+1, ModeOverwrite, ops)
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOverwriteQueueKeepsLatest(t *testing.T) {
+	q := NewQueueService()
+	q.Initialize(3, ModeOverwrite)
+
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(strconv.Itoa(i))
+	}
+
+	if latest, err := q.Latest(2); err != nil || !slices.Equal(latest, []string{"4", "5"}) {
+		t.Fatalf("Latest(2) = %q, %v, want %q", latest, err, []string{"4", "5"})
+	}
+	if contents, _ := q.Contents(); !slices.Equal(contents, []string{"3", "4", "5"}) {
+		t.Fatalf("Contents() = %q, want %q", contents, []string{"3", "4", "5"})
+	}
+	if _, err := q.Latest(-1); err != ErrInvalidCount {
+		t.Fatalf("Latest(-1) = %v, want %v", err, ErrInvalidCount)
+	}
+}
+
+func TestQueueRejectsUnknownMode(t *testing.T) {
+	q := NewQueueService()
+
+	if err := q.Initialize(3, "lossy"); err != ErrInvalidMode {
+		t.Fatalf("Initialize() with unknown mode = %v, want %v", err, ErrInvalidMode)
+	}
+}
+
+func TestQueueHeadAfterWrap(t *testing.T) {
+	q := NewQueueService()
+	q.Initialize(3, ModeBounded)
+
+	for _, value := range []string{"a", "b", "c"} {
+		q.Enqueue(value)
+	}
+	q.Dequeue()
+	q.Dequeue()
+	q.Enqueue("d")
+
+	// The head now sits in the last slot and the tail has wrapped to the
+	// first one.
+	if head, err := q.Head(); err != nil || head != "c" {
+		t.Fatalf("Head() = %q, %v, want %q", head, err, "c")
+	}
+	if tail, err := q.Tail(); err != nil || tail != "d" {
+		t.Fatalf("Tail() = %q, %v, want %q", tail, err, "d")
+	}
+}
+
+func TestQueueIsEmpty(t *testing.T) {
+	q := NewQueueService()
+
+	if _, err := q.IsEmpty(); err != ErrUninitialized {
+		t.Fatalf("IsEmpty() before Initialize = %v, want %v", err, ErrUninitialized)
+	}
+
+	q.Initialize(2, ModeBounded)
+	if empty, err := q.IsEmpty(); err != nil || !empty {
+		t.Fatalf("IsEmpty() on new queue = %v, %v, want true, nil", empty, err)
+	}
+
+	q.Enqueue("a")
+	if empty, err := q.IsEmpty(); err != nil || empty {
+		t.Fatalf("IsEmpty() with one element = %v, %v, want false, nil", empty, err)
+	}
+}
+
+func TestQueueUndoOperationsMatchSliceModel(t *testing.T) {
+	property := func(capacity uint8, ops []byte) bool {
+		q := NewQueueService()
+		q.Initialize(int(capacity%8)+1, ModeBounded)
+		m := model{capacity: int(capacity%8) + 1, mode: ModeBounded}
+
+		for step, op := range ops {
+			value := strconv.Itoa(step)
+
+			switch op % 4 {
+			case 0:
+				if _, _, err := q.Enqueue(value); err == nil {
+					m.values = append(m.values, value)
+				}
+			case 1:
+				if _, err := q.Dequeue(); err == nil {
+					m.values = m.values[1:]
+				}
+			case 2:
+				removed, err := q.RemoveTail()
+				if err == nil {
+					if removed != m.values[len(m.values)-1] {
+						t.Logf("step %d: RemoveTail() = %q, want %q", step, removed, m.values[len(m.values)-1])
+						return false
+					}
+					m.values = m.values[:len(m.values)-1]
+				}
+			case 3:
+				if q.RestoreHead(value) == nil {
+					m.values = slices.Insert(m.values, 0, value)
+				}
+			}
+
+			if !matches(t, step, q, m) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
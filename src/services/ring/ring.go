@@ -0,0 +1,9 @@
+// Package ring holds the index arithmetic shared by the ring buffers.
+package ring
+
+// Slot maps an offset from head onto a ring of the given capacity. The
+// offset may be up to one lap out of range either way; Go's % keeps the
+// sign of the dividend, so a negative one needs the extra lap.
+func Slot(head int, offset int, capacity int) int {
+	return (head + offset + capacity) % capacity
+}
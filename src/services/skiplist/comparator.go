@@ -0,0 +1,21 @@
+package skiplist
+
+// Comparator orders two keys: negative when a < b, zero when they are equal and
+// positive when a > b. cmp.Compare already covers ints, floats and strings.
+type Comparator[K any] func(a K, b K) int
+
+// Tuple is a composite key ordered by First and then by Second.
+type Tuple[A, B any] struct {
+	First  A
+	Second B
+}
+
+func CompareTuples[A, B any](first Comparator[A], second Comparator[B]) Comparator[Tuple[A, B]] {
+	return func(a Tuple[A, B], b Tuple[A, B]) int {
+		if result := first(a.First, b.First); result != 0 {
+			return result
+		}
+
+		return second(a.Second, b.Second)
+	}
+}
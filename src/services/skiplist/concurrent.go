@@ -0,0 +1,710 @@
+package skiplist
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConcurrentSkipList is the lazy skip list by Herlihy, Lev, Luchangco and
+// Shavit. Searches never lock; Insert and Delete lock only the predecessors of
+// the node they touch and validate them before relinking. A node is logically
+// removed when marked and only visible once fully linked.
+//
+// Spans cannot be kept consistent under per-node locking, so the rank methods
+// walk level 0 in O(n) instead of the O(log n) of the sequential list.
+type ConcurrentSkipList[K, V any] struct {
+	compare Comparator[K]
+	state   atomic.Pointer[concurrentState[K, V]]
+}
+
+// concurrentState holds one generation of the list. Initialize swaps in a new
+// state, so operations already running finish against the old one.
+type concurrentState[K, V any] struct {
+	head        *concurrentNode[K, V]
+	compare     Comparator[K]
+	maxLevel    int
+	probability float64
+	seed        int64
+	rngMu       sync.Mutex
+	rng         *rand.Rand
+	size        atomic.Int64
+}
+
+type concurrentNode[K, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[concurrentNode[K, V]]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+func NewConcurrent[K, V any](compare Comparator[K]) *ConcurrentSkipList[K, V] {
+	skipList := &ConcurrentSkipList[K, V]{compare: compare}
+	skipList.Initialize(DefaultMaxLevel, DefaultProbability, 0)
+
+	return skipList
+}
+
+// Initialize implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Initialize(maxLevel int, probability float64, seed int64) error {
+	if maxLevel == 0 {
+		maxLevel = DefaultMaxLevel
+	}
+	if probability == 0 {
+		probability = DefaultProbability
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	if maxLevel < 1 || maxLevel > MaxAllowedLevel {
+		return ErrInvalidLevel
+	}
+	if probability <= 0 || probability >= 1 {
+		return ErrInvalidProb
+	}
+
+	head := &concurrentNode[K, V]{next: make([]atomic.Pointer[concurrentNode[K, V]], maxLevel)}
+	head.fullyLinked.Store(true)
+
+	c.state.Store(&concurrentState[K, V]{
+		head:        head,
+		compare:     c.compare,
+		maxLevel:    maxLevel,
+		probability: probability,
+		seed:        seed,
+		rng:         rand.New(rand.NewSource(seed)),
+	})
+
+	return nil
+}
+
+// Insert implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Insert(key K, value V) (oldValue V, replaced bool) {
+	st := c.state.Load()
+
+	height := -1
+	preds := make([]*concurrentNode[K, V], st.maxLevel)
+	succs := make([]*concurrentNode[K, V], st.maxLevel)
+
+	for {
+		levelFound := st.find(key, preds, succs)
+
+		if levelFound != -1 {
+			foundNode := succs[levelFound]
+			if foundNode.marked.Load() {
+				continue
+			}
+
+			for !foundNode.fullyLinked.Load() {
+				runtime.Gosched()
+			}
+
+			foundNode.mu.Lock()
+			if foundNode.marked.Load() {
+				foundNode.mu.Unlock()
+				continue
+			}
+			oldValue = *foundNode.value.Swap(&value)
+			foundNode.mu.Unlock()
+
+			return oldValue, true
+		}
+
+		if height == -1 {
+			height = st.randomLevel()
+		}
+
+		highestLocked := -1
+		valid := true
+		for level := 0; valid && level <= height; level++ {
+			pred, succ := preds[level], succs[level]
+			if level == 0 || pred != preds[level-1] {
+				pred.mu.Lock()
+			}
+			highestLocked = level
+
+			valid = !pred.marked.Load() &&
+				(succ == nil || !succ.marked.Load()) &&
+				pred.next[level].Load() == succ
+		}
+
+		if !valid {
+			unlockPreds(preds, highestLocked)
+			continue
+		}
+
+		newNode := &concurrentNode[K, V]{
+			key:  key,
+			next: make([]atomic.Pointer[concurrentNode[K, V]], height+1),
+		}
+		newNode.value.Store(&value)
+
+		for level := 0; level <= height; level++ {
+			newNode.next[level].Store(succs[level])
+		}
+		for level := 0; level <= height; level++ {
+			preds[level].next[level].Store(newNode)
+		}
+		newNode.fullyLinked.Store(true)
+
+		unlockPreds(preds, highestLocked)
+		st.size.Add(1)
+
+		return oldValue, false
+	}
+}
+
+// Delete implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Delete(key K) (deletedValue V, err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return deletedValue, err
+	}
+
+	preds := make([]*concurrentNode[K, V], st.maxLevel)
+	succs := make([]*concurrentNode[K, V], st.maxLevel)
+
+	var victim *concurrentNode[K, V]
+	isMarked := false
+	height := -1
+
+	for {
+		levelFound := st.find(key, preds, succs)
+		if levelFound != -1 {
+			victim = succs[levelFound]
+		}
+
+		if !isMarked {
+			if levelFound == -1 || !victim.fullyLinked.Load() ||
+				len(victim.next)-1 != levelFound || victim.marked.Load() {
+				return deletedValue, ErrNotFound
+			}
+
+			height = len(victim.next) - 1
+			victim.mu.Lock()
+			if victim.marked.Load() {
+				victim.mu.Unlock()
+				return deletedValue, ErrNotFound
+			}
+			victim.marked.Store(true)
+			isMarked = true
+		}
+
+		highestLocked := -1
+		valid := true
+		for level := 0; valid && level <= height; level++ {
+			pred := preds[level]
+			if level == 0 || pred != preds[level-1] {
+				pred.mu.Lock()
+			}
+			highestLocked = level
+
+			valid = !pred.marked.Load() && pred.next[level].Load() == victim
+		}
+
+		if !valid {
+			unlockPreds(preds, highestLocked)
+			continue
+		}
+
+		for level := height; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+
+		deletedValue = *victim.value.Load()
+		victim.mu.Unlock()
+		unlockPreds(preds, highestLocked)
+		st.size.Add(-1)
+
+		return deletedValue, nil
+	}
+}
+
+// Search implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Search(key K) (valueFound V, err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return valueFound, err
+	}
+
+	foundNode := st.ceilingNode(key)
+	if foundNode == nil || st.compare(foundNode.key, key) != 0 {
+		return valueFound, ErrNotFound
+	}
+
+	return *foundNode.value.Load(), nil
+}
+
+// Contains implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Contains(key K) (found bool, err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return false, err
+	}
+
+	foundNode := st.ceilingNode(key)
+
+	return foundNode != nil && st.compare(foundNode.key, key) == 0, nil
+}
+
+// Range implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Range(lo K, hi K, limit int) (entries []Entry[K, V], err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	if st.compare(lo, hi) > 0 {
+		return nil, ErrInvalidRange
+	}
+
+	entries = []Entry[K, V]{}
+	for currentNode := st.ceilingNode(lo); currentNode != nil && st.compare(currentNode.key, hi) <= 0; currentNode = currentNode.nextLive() {
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+
+		entries = append(entries, currentNode.entry())
+	}
+
+	return entries, nil
+}
+
+// Floor implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Floor(key K) (entry Entry[K, V], err error) {
+	return c.lookup(func(st *concurrentState[K, V]) *concurrentNode[K, V] {
+		return st.floorNode(key)
+	})
+}
+
+// Ceiling implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Ceiling(key K) (entry Entry[K, V], err error) {
+	return c.lookup(func(st *concurrentState[K, V]) *concurrentNode[K, V] {
+		return st.ceilingNode(key)
+	})
+}
+
+// First implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) First() (entry Entry[K, V], err error) {
+	return c.lookup(func(st *concurrentState[K, V]) *concurrentNode[K, V] {
+		return st.head.nextLive()
+	})
+}
+
+// Last implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Last() (entry Entry[K, V], err error) {
+	return c.lookup(func(st *concurrentState[K, V]) *concurrentNode[K, V] {
+		return st.lastNode()
+	})
+}
+
+// Next implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Next(key K) (entry Entry[K, V], err error) {
+	return c.lookup(func(st *concurrentState[K, V]) *concurrentNode[K, V] {
+		successorNode := st.ceilingNode(key)
+		if successorNode != nil && st.compare(successorNode.key, key) == 0 {
+			successorNode = successorNode.nextLive()
+		}
+		return successorNode
+	})
+}
+
+// Prev implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Prev(key K) (entry Entry[K, V], err error) {
+	return c.lookup(func(st *concurrentState[K, V]) *concurrentNode[K, V] {
+		return st.lowerNode(key)
+	})
+}
+
+// Iterate implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Iterate(from K, limit int, reverse bool) (entries []Entry[K, V], err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	entries = []Entry[K, V]{}
+
+	if !reverse {
+		for currentNode := st.ceilingNode(from); currentNode != nil && (limit <= 0 || len(entries) < limit); currentNode = currentNode.nextLive() {
+			entries = append(entries, currentNode.entry())
+		}
+
+		return entries, nil
+	}
+
+	for currentNode := st.floorNode(from); currentNode != nil && (limit <= 0 || len(entries) < limit); currentNode = st.lowerNode(currentNode.key) {
+		entries = append(entries, currentNode.entry())
+	}
+
+	return entries, nil
+}
+
+// Rank implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Rank(key K) (rank int, err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return -1, err
+	}
+
+	rank = 0
+	for currentNode := st.head.nextLive(); currentNode != nil && st.compare(currentNode.key, key) <= 0; currentNode = currentNode.nextLive() {
+		if st.compare(currentNode.key, key) == 0 {
+			return rank, nil
+		}
+		rank++
+	}
+
+	return -1, ErrNotFound
+}
+
+// GetByRank implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) GetByRank(rank int) (entry Entry[K, V], err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	if rank < 0 {
+		return entry, ErrRankNotFound
+	}
+
+	currentNode := st.head.nextLive()
+	for i := 0; i < rank && currentNode != nil; i++ {
+		currentNode = currentNode.nextLive()
+	}
+
+	if currentNode == nil {
+		return entry, ErrRankNotFound
+	}
+
+	return currentNode.entry(), nil
+}
+
+// RangeByRank implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) RangeByRank(start int, stop int) (entries []Entry[K, V], err error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	size := int(st.size.Load())
+	if start < 0 {
+		start += size
+	}
+	if stop < 0 {
+		stop += size
+	}
+	if start < 0 {
+		start = 0
+	}
+
+	entries = []Entry[K, V]{}
+	if start > stop {
+		return entries, nil
+	}
+
+	rank := 0
+	for currentNode := st.head.nextLive(); currentNode != nil && rank <= stop; currentNode = currentNode.nextLive() {
+		if rank >= start {
+			entries = append(entries, currentNode.entry())
+		}
+		rank++
+	}
+
+	return entries, nil
+}
+
+// Stats implements OrderedMap.
+// Counts are taken while other goroutines may be writing, so they describe a
+// recent state rather than an exact one.
+func (c *ConcurrentSkipList[K, V]) Stats(sample int) Stats {
+	st := c.state.Load()
+
+	if sample <= 0 {
+		sample = DefaultStatsSample
+	}
+
+	nodesPerLevel := []int{}
+	for level := 0; level < st.maxLevel; level++ {
+		count := 0
+		for currentNode := st.head.nextLiveAt(level); currentNode != nil; currentNode = currentNode.nextLiveAt(level) {
+			count++
+		}
+
+		if count == 0 && level > 0 {
+			break
+		}
+		nodesPerLevel = append(nodesPerLevel, count)
+	}
+
+	stats := Stats{
+		Size:          nodesPerLevel[0],
+		Level:         len(nodesPerLevel) - 1,
+		MaxLevel:      st.maxLevel,
+		Probability:   st.probability,
+		Seed:          st.seed,
+		NodesPerLevel: nodesPerLevel,
+	}
+
+	keys := st.liveKeys()
+	if len(keys) == 0 {
+		return stats
+	}
+
+	if sample > len(keys) {
+		sample = len(keys)
+	}
+
+	totalHops := 0
+	for i := 0; i < sample; i++ {
+		totalHops += st.searchHops(keys[i*len(keys)/sample])
+	}
+
+	stats.SampledKeys = sample
+	stats.AverageSearchHops = float64(totalHops) / float64(sample)
+
+	return stats
+}
+
+// Debug implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Debug() Snapshot[K] {
+	st := c.state.Load()
+
+	labels := []string{}
+	heights := []int{}
+	positions := map[*concurrentNode[K, V]]int{}
+	topLevel := 0
+	for currentNode := st.head.nextLive(); currentNode != nil; currentNode = currentNode.nextLive() {
+		positions[currentNode] = len(labels)
+		labels = append(labels, fmt.Sprint(currentNode.key))
+		heights = append(heights, len(currentNode.next))
+
+		if len(currentNode.next)-1 > topLevel {
+			topLevel = len(currentNode.next) - 1
+		}
+	}
+
+	snapshot := Snapshot[K]{
+		Diagram: make([]string, 0, topLevel+1),
+		Levels:  make([]Level[K], 0, topLevel+1),
+	}
+
+	for level := topLevel; level >= 0; level-- {
+		snapshot.Diagram = append(snapshot.Diagram, renderLevel(level, labels, heights))
+
+		described := Level[K]{Level: level, Nodes: []LevelNode[K]{}}
+		for currentNode := st.head.nextLiveAt(level); currentNode != nil; currentNode = currentNode.nextLiveAt(level) {
+			position, ok := positions[currentNode]
+			if !ok {
+				continue
+			}
+
+			levelNode := LevelNode[K]{Key: currentNode.key, Span: len(labels) - position - 1}
+			if nextNode := currentNode.nextLiveAt(level); nextNode != nil {
+				nextKey := nextNode.key
+				levelNode.Next = &nextKey
+				if nextPosition, ok := positions[nextNode]; ok {
+					levelNode.Span = nextPosition - position
+				}
+			}
+
+			described.Nodes = append(described.Nodes, levelNode)
+		}
+
+		snapshot.Levels = append(snapshot.Levels, described)
+	}
+
+	return snapshot
+}
+
+// Size implements OrderedMap.
+func (c *ConcurrentSkipList[K, V]) Size() int {
+	return int(c.state.Load().size.Load())
+}
+
+/* Private Methods */
+
+func (c *ConcurrentSkipList[K, V]) lookup(locate func(st *concurrentState[K, V]) *concurrentNode[K, V]) (Entry[K, V], error) {
+	st := c.state.Load()
+	if err := st.validateEmpty(); err != nil {
+		return Entry[K, V]{}, err
+	}
+
+	foundNode := locate(st)
+	if foundNode == nil {
+		return Entry[K, V]{}, ErrNotFound
+	}
+
+	return foundNode.entry(), nil
+}
+
+func (st *concurrentState[K, V]) randomLevel() int {
+	st.rngMu.Lock()
+	defer st.rngMu.Unlock()
+
+	level := 0
+	for st.rng.Float64() < st.probability && level < st.maxLevel-1 {
+		level++
+	}
+
+	return level
+}
+
+// find fills preds and succs with the nodes around key on every level and
+// returns the highest level where key was found, or -1.
+func (st *concurrentState[K, V]) find(key K, preds []*concurrentNode[K, V], succs []*concurrentNode[K, V]) int {
+	levelFound := -1
+
+	pred := st.head
+	for level := st.maxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && st.compare(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+
+		if levelFound == -1 && curr != nil && st.compare(curr.key, key) == 0 {
+			levelFound = level
+		}
+
+		preds[level] = pred
+		succs[level] = curr
+	}
+
+	return levelFound
+}
+
+// lowerNode returns the live node with the greatest key below key. If the
+// predecessor found is being inserted or removed, it searches again below it.
+func (st *concurrentState[K, V]) lowerNode(key K) *concurrentNode[K, V] {
+	for {
+		pred := st.head
+		for level := st.maxLevel - 1; level >= 0; level-- {
+			for next := pred.next[level].Load(); next != nil && st.compare(next.key, key) < 0; next = pred.next[level].Load() {
+				pred = next
+			}
+		}
+
+		if pred == st.head {
+			return nil
+		}
+
+		if pred.isLive() {
+			return pred
+		}
+
+		key = pred.key
+	}
+}
+
+func (st *concurrentState[K, V]) ceilingNode(key K) *concurrentNode[K, V] {
+	pred := st.head
+	for level := st.maxLevel - 1; level >= 0; level-- {
+		for next := pred.next[level].Load(); next != nil && st.compare(next.key, key) < 0; next = pred.next[level].Load() {
+			pred = next
+		}
+	}
+
+	return pred.nextLive()
+}
+
+func (st *concurrentState[K, V]) floorNode(key K) *concurrentNode[K, V] {
+	foundNode := st.ceilingNode(key)
+	if foundNode != nil && st.compare(foundNode.key, key) == 0 {
+		return foundNode
+	}
+
+	return st.lowerNode(key)
+}
+
+func (st *concurrentState[K, V]) lastNode() *concurrentNode[K, V] {
+	pred := st.head
+	for level := st.maxLevel - 1; level >= 0; level-- {
+		for next := pred.next[level].Load(); next != nil; next = pred.next[level].Load() {
+			pred = next
+		}
+	}
+
+	if pred == st.head {
+		return nil
+	}
+
+	if pred.isLive() {
+		return pred
+	}
+
+	return st.lowerNode(pred.key)
+}
+
+func (st *concurrentState[K, V]) searchHops(key K) int {
+	hops := 0
+
+	pred := st.head
+	for level := st.maxLevel - 1; level >= 0; level-- {
+		for next := pred.next[level].Load(); next != nil && st.compare(next.key, key) < 0; next = pred.next[level].Load() {
+			pred = next
+			hops++
+		}
+	}
+
+	if next := pred.next[0].Load(); next != nil && st.compare(next.key, key) == 0 {
+		hops++
+	}
+
+	return hops
+}
+
+func (st *concurrentState[K, V]) liveKeys() []K {
+	keys := []K{}
+	for currentNode := st.head.nextLive(); currentNode != nil; currentNode = currentNode.nextLive() {
+		keys = append(keys, currentNode.key)
+	}
+
+	return keys
+}
+
+func (st *concurrentState[K, V]) validateEmpty() error {
+	if st.size.Load() == 0 {
+		return ErrEmpty
+	}
+
+	return nil
+}
+
+func (n *concurrentNode[K, V]) isLive() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+func (n *concurrentNode[K, V]) nextLive() *concurrentNode[K, V] {
+	return n.nextLiveAt(0)
+}
+
+// nextLiveAt follows level, skipping nodes that are marked or still linking.
+// Marked nodes keep their forward pointers, so walking through them is safe.
+func (n *concurrentNode[K, V]) nextLiveAt(level int) *concurrentNode[K, V] {
+	currentNode := n.next[level].Load()
+	for currentNode != nil && !currentNode.isLive() {
+		currentNode = currentNode.next[level].Load()
+	}
+
+	return currentNode
+}
+
+func (n *concurrentNode[K, V]) entry() Entry[K, V] {
+	return Entry[K, V]{Key: n.key, Value: *n.value.Load()}
+}
+
+func unlockPreds[K, V any](preds []*concurrentNode[K, V], highestLocked int) {
+	for level := 0; level <= highestLocked; level++ {
+		if level == 0 || preds[level] != preds[level-1] {
+			preds[level].mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package skiplist
+
+import (
+	"cmp"
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSkipListDisjointInserts(t *testing.T) {
+	const workers = 8
+	const perWorker = 500
+
+	list := NewConcurrentSkipList()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				key := i*workers + w
+				list.Insert(key, strconv.Itoa(key))
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for key := 0; key < workers*perWorker; key++ {
+		value, err := list.Search(key)
+		if err != nil || value != strconv.Itoa(key) {
+			t.Fatalf("Search(%d) = %q, %v", key, value, err)
+		}
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				key := i*workers + w
+				if _, err := list.Delete(key); err != nil {
+					t.Errorf("Delete(%d) = %v", key, err)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if _, err := list.First(); err != ErrEmpty {
+		t.Fatalf("First() after deleting every key = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestConcurrentSkipListStress(t *testing.T) {
+	const workers = 8
+	const operations = 4000
+	const keySpace = 256
+
+	list := NewConcurrent[int, string](cmp.Compare[int])
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(seed))
+
+			for i := 0; i < operations; i++ {
+				key := r.Intn(keySpace)
+				switch r.Intn(6) {
+				case 0, 1:
+					list.Insert(key, strconv.Itoa(key))
+				case 2:
+					list.Delete(key)
+				case 3:
+					if value, err := list.Search(key); err == nil && value != strconv.Itoa(key) {
+						t.Errorf("Search(%d) = %q", key, value)
+					}
+				case 4:
+					entries, _ := list.Range(key, key+16, 0)
+					for j := 1; j < len(entries); j++ {
+						if entries[j-1].Key >= entries[j].Key {
+							t.Errorf("Range returned unsorted keys %v", entries)
+							break
+						}
+					}
+				case 5:
+					list.Floor(key)
+					list.Prev(key)
+				}
+			}
+		}(int64(w))
+	}
+	wg.Wait()
+
+	st := list.state.Load()
+
+	levelZero := map[int]bool{}
+	previous := -1
+	for currentNode := st.head.next[0].Load(); currentNode != nil; currentNode = currentNode.next[0].Load() {
+		if currentNode.marked.Load() || !currentNode.fullyLinked.Load() {
+			t.Fatalf("node %d left half removed or half inserted", currentNode.key)
+		}
+		if currentNode.key <= previous {
+			t.Fatalf("level 0 out of order: %d after %d", currentNode.key, previous)
+		}
+		previous = currentNode.key
+		levelZero[currentNode.key] = true
+	}
+
+	if int(st.size.Load()) != len(levelZero) {
+		t.Fatalf("size = %d, level 0 holds %d nodes", st.size.Load(), len(levelZero))
+	}
+
+	for level := 1; level < st.maxLevel; level++ {
+		for currentNode := st.head.next[level].Load(); currentNode != nil; currentNode = currentNode.next[level].Load() {
+			if !levelZero[currentNode.key] {
+				t.Fatalf("level %d links key %d missing from level 0", level, currentNode.key)
+			}
+		}
+	}
+}
+
+func TestConcurrentSkipListRanksUnderConcurrentWrites(t *testing.T) {
+	const workers = 8
+	const perWorker = 500
+
+	list := NewConcurrentSkipList()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				key := i*workers + w
+				list.Insert(key, strconv.Itoa(key))
+				list.Rank(key)
+				list.RangeByRank(0, 10)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for key := 0; key < workers*perWorker; key++ {
+		rank, err := list.Rank(key)
+		if err != nil || rank != key {
+			t.Fatalf("Rank(%d) = %d, %v, want %d", key, rank, err, key)
+		}
+	}
+}
@@ -1,160 +1,151 @@
 package skiplist
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"time"
 )
 
 var (
-	ErrEmpty    = errors.New("skiplist is empty")
-	ErrNotFound = errors.New("skiplist node not found")
+	ErrEmpty        = errors.New("skiplist is empty")
+	ErrNotFound     = errors.New("skiplist node not found")
+	ErrInvalidRange = errors.New("skiplist range is invalid")
+	ErrRankNotFound = errors.New("skiplist rank not found")
+	ErrInvalidLevel = errors.New("skiplist max level must be between 1 and 32")
+	ErrInvalidProb  = errors.New("skiplist probability must be between 0 and 1")
 )
 
-type SkipListService interface {
+const (
+	DefaultMaxLevel    int     = 16
+	DefaultProbability float64 = 0.5
+	MaxAllowedLevel    int     = 32
+	DefaultStatsSample int     = 100
+)
+
+// OrderedMap is implemented by both SkipList and ConcurrentSkipList.
+type OrderedMap[K, V any] interface {
+	// Configuration Methods
+	Initialize(maxLevel int, probability float64, seed int64) error
+
 	// Insertion Methods
-	Insert(key int, value string) (old string, replaced bool)
-	Seed() (size int)
+	Insert(key K, value V) (old V, replaced bool)
 
 	// Accessibility Methods
-	Search(key int) (valueFound string, err error)
-	Contains(key int) (found bool, err error)
+	Search(key K) (valueFound V, err error)
+	Contains(key K) (found bool, err error)
+	Size() int
+
+	// Ordered Methods
+	Range(lo K, hi K, limit int) (entries []Entry[K, V], err error)
+	Floor(key K) (entry Entry[K, V], err error)
+	Ceiling(key K) (entry Entry[K, V], err error)
+	First() (entry Entry[K, V], err error)
+	Last() (entry Entry[K, V], err error)
+	Next(key K) (entry Entry[K, V], err error)
+	Prev(key K) (entry Entry[K, V], err error)
+	Iterate(from K, limit int, reverse bool) (entries []Entry[K, V], err error)
+
+	// Rank Methods
+	Rank(key K) (rank int, err error)
+	GetByRank(rank int) (entry Entry[K, V], err error)
+	RangeByRank(start int, stop int) (entries []Entry[K, V], err error)
 
 	// Deletion Methods
-	Delete(key int) (deletedValue string, err error)
-}
-
-type Node struct {
-	Key   int
-	Value string
-	Next  []*Node
-}
+	Delete(key K) (deletedValue V, err error)
 
-type skipList struct {
-	head        *Node
-	maxLevel    int
-	level       int
-	probability float64
-	size        int
+	// Utility Methods
+	Stats(sample int) Stats
+	Debug() Snapshot[K]
 }
 
-func NewSkipList() SkipListService {
-	skipList := &skipList{
-		head:        &Node{},
-		maxLevel:    5,
-		level:       0,
-		probability: 0.5,
-		size:        0,
-	}
-	skipList.head.Next = make([]*Node, skipList.maxLevel)
+// KeyedSkipListService is the string valued list served over HTTP.
+type KeyedSkipListService[K any] interface {
+	OrderedMap[K, string]
 
-	return skipList
+	Seed() (size int)
 }
 
-// Contains implements SkipListService.
-func (s *skipList) Contains(key int) (found bool, err error) {
-	if err := s.validateEmpty(); err != nil {
-		return false, err
-	}
-	baseList := s.traverseList(key)
-	currentNode := baseList[0]
+type SkipListService = KeyedSkipListService[int]
 
-	if currentNode.Next[0] != nil && currentNode.Next[0].Key == key {
-		return true, nil
-	}
+type StringSkipListService = KeyedSkipListService[string]
 
-	return false, nil
+type Entry[K, V any] struct {
+	Key   K
+	Value V
 }
 
-// Delete implements SkipListService.
-func (s *skipList) Delete(key int) (deletedValue string, err error) {
-	if err := s.validateEmpty(); err != nil {
-		return "", err
-	}
-	update := s.traverseList(key)
-
-	currentNode := update[0]
-	successorNode := currentNode.Next[0]
-
-	if successorNode == nil || successorNode.Key != key {
-		return "", ErrNotFound
-	}
-
-	deletedValue = successorNode.Value
-	for i := 0; i < len(successorNode.Next); i++ {
-		if update[i].Next[i] == successorNode {
-			update[i].Next[i] = successorNode.Next[i]
-		}
-	}
-
-	for s.level > 0 && s.head.Next[s.level] == nil {
-		s.level--
-	}
-
-	s.size--
-
-	return deletedValue, nil
+type Stats struct {
+	Size              int
+	Level             int
+	MaxLevel          int
+	Probability       float64
+	Seed              int64
+	NodesPerLevel     []int
+	SampledKeys       int
+	AverageSearchHops float64
 }
 
-// Insert implements SkipListService.
-func (s *skipList) Insert(key int, value string) (oldValue string, replaced bool) {
-	update := s.traverseList(key)
-
-	currentNode := update[0]
-	successorNode := currentNode.Next[0]
-
-	if successorNode != nil && successorNode.Key == key {
-		oldValue = successorNode.Value
-		successorNode.Value = value
-		return oldValue, true
-	}
+// Snapshot describes the list level by level: Diagram holds one text row per
+// level (top level first) and Levels the same pointers as data.
+type Snapshot[K any] struct {
+	Diagram []string
+	Levels  []Level[K]
+}
 
-	height := s.randomLevel()
+type Level[K any] struct {
+	Level int
+	Nodes []LevelNode[K]
+}
 
-	newNode := &Node{
-		Key:   key,
-		Value: value,
-		Next:  make([]*Node, height+1),
-	}
+type LevelNode[K any] struct {
+	Key  K
+	Span int
+	Next *K
+}
 
-	if s.level < height {
-		for i := s.level + 1; i <= height; i++ {
-			update[i] = s.head
-		}
+// seededSkipList adapts an OrderedMap to the HTTP service, adding Seed on top
+// of whichever implementation it wraps.
+type seededSkipList[K any] struct {
+	OrderedMap[K, string]
+	seedKey func(n int) K
+}
 
-		s.level = height
+func NewSkipList() SkipListService {
+	return &seededSkipList[int]{
+		OrderedMap: New[int, string](cmp.Compare[int]),
+		seedKey:    intSeedKey,
 	}
+}
 
-	for i := height; i >= 0; i-- {
-		newNode.Next[i] = update[i].Next[i]
-		update[i].Next[i] = newNode
+func NewConcurrentSkipList() SkipListService {
+	return &seededSkipList[int]{
+		OrderedMap: NewConcurrent[int, string](cmp.Compare[int]),
+		seedKey:    intSeedKey,
 	}
-
-	s.size++
-
-	fmt.Println(newNode, "level: ", height)
-	return "", false
 }
 
-// Search implements SkipListService.
-func (s *skipList) Search(key int) (valueFound string, err error) {
-	if err := s.validateEmpty(); err != nil {
-		return "", err
+func NewConcurrentStringSkipList() StringSkipListService {
+	return &seededSkipList[string]{
+		OrderedMap: NewConcurrent[string, string](cmp.Compare[string]),
+		seedKey:    stringSeedKey,
 	}
+}
 
-	baseList := s.traverseList(key)
-	currentNode := baseList[0]
-
-	if currentNode.Next[0] != nil && currentNode.Next[0].Key == key {
-		return currentNode.Next[0].Value, nil
+func (s *seededSkipList[K]) Seed() (size int) {
+	result := seedKeys()
+	for i := 0; i < len(result); i++ {
+		s.Insert(s.seedKey(result[i]), "Data "+strconv.Itoa(result[i]))
 	}
 
-	return "", ErrNotFound
+	return s.Size()
 }
 
-func (s *skipList) Seed() (size int) {
+/* Utils */
+func seedKeys() []int {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	n := 500
@@ -170,64 +161,33 @@ func (s *skipList) Seed() (size int) {
 		nums[i], nums[j] = nums[j], nums[i]
 	})
 
-	result := nums[:n]
-	print(result)
-	for i := 0; i < len(result); i++ {
-		s.Insert(result[i], "Data "+strconv.Itoa(result[i]))
-	}
-
-	return s.size
+	return nums[:n]
 }
 
-/* Private Methods */
-func (s *skipList) randomLevel() int {
-	level := 0
-
-	for rand.Float64() < s.probability && level < s.maxLevel-1 {
-		level++
-	}
-
-	return level
+func intSeedKey(n int) int {
+	return n
 }
 
-func (s *skipList) traverseList(key int) []*Node {
-	update := make([]*Node, s.maxLevel)
-
-	currentNode := s.head
-	for i := s.level; i >= 0; i-- {
-		for currentNode.Next[i] != nil && currentNode.Next[i].Key < key {
-			currentNode = currentNode.Next[i]
-		}
-
-		update[i] = currentNode
-	}
-
-	return update
+func stringSeedKey(n int) string {
+	return fmt.Sprintf("key_%04d", n)
 }
 
-/* Validations */
-func (s *skipList) validateEmpty() error {
-	if s.size == 0 {
-		return ErrEmpty
-	}
+// renderLevel draws one diagram row; heights[i] is the number of levels the
+// node labelled labels[i] takes part in, so columns line up across rows.
+func renderLevel(level int, labels []string, heights []int) string {
+	var row strings.Builder
 
-	return nil
-}
-
-/* Utils */
-func (n *Node) String() string {
-	if n == nil {
-		return "<nil>"
-	}
+	fmt.Fprintf(&row, "L%-2d HEAD ", level)
+	for i, label := range labels {
+		cell := "-> " + label + " "
 
-	keys := []int{}
-	for _, next := range n.Next {
-		if next != nil {
-			keys = append(keys, next.Key)
+		if heights[i] > level {
+			row.WriteString(cell)
 		} else {
-			keys = append(keys, -1)
+			row.WriteString(strings.Repeat("-", len(cell)))
 		}
 	}
+	row.WriteString("-> NIL")
 
-	return fmt.Sprintf("Node{Key:%d, Value:%s, NextKeys:%v},", n.Key, n.Value, keys)
+	return row.String()
 }
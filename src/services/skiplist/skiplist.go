@@ -0,0 +1,603 @@
+package skiplist
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Node keeps, next to every forward pointer, the number of level 0 steps that
+// pointer skips (Span). Summing spans along a search path yields the rank.
+type Node[K, V any] struct {
+	Key   K
+	Value V
+	Next  []*Node[K, V]
+	Span  []int
+}
+
+// SkipList is the single goroutine skip list, ordered by the comparator given
+// to New. It is not safe for concurrent use; see ConcurrentSkipList.
+type SkipList[K, V any] struct {
+	head        *Node[K, V]
+	compare     Comparator[K]
+	maxLevel    int
+	level       int
+	probability float64
+	seed        int64
+	rng         *rand.Rand
+	size        int
+}
+
+func New[K, V any](compare Comparator[K]) *SkipList[K, V] {
+	skipList := &SkipList[K, V]{compare: compare}
+	skipList.Initialize(DefaultMaxLevel, DefaultProbability, 0)
+
+	return skipList
+}
+
+// Initialize implements OrderedMap.
+// It discards every node; zero values fall back to the defaults and a zero
+// seed picks a time based one.
+func (s *SkipList[K, V]) Initialize(maxLevel int, probability float64, seed int64) error {
+	if maxLevel == 0 {
+		maxLevel = DefaultMaxLevel
+	}
+	if probability == 0 {
+		probability = DefaultProbability
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	if maxLevel < 1 || maxLevel > MaxAllowedLevel {
+		return ErrInvalidLevel
+	}
+	if probability <= 0 || probability >= 1 {
+		return ErrInvalidProb
+	}
+
+	s.head = &Node[K, V]{
+		Next: make([]*Node[K, V], maxLevel),
+		Span: make([]int, maxLevel),
+	}
+	s.maxLevel = maxLevel
+	s.level = 0
+	s.probability = probability
+	s.seed = seed
+	s.rng = rand.New(rand.NewSource(seed))
+	s.size = 0
+
+	return nil
+}
+
+// Contains implements OrderedMap.
+func (s *SkipList[K, V]) Contains(key K) (found bool, err error) {
+	if err := s.validateEmpty(); err != nil {
+		return false, err
+	}
+	baseList := s.traverseList(key)
+	currentNode := baseList[0]
+
+	if currentNode.Next[0] != nil && s.compare(currentNode.Next[0].Key, key) == 0 {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// Delete implements OrderedMap.
+func (s *SkipList[K, V]) Delete(key K) (deletedValue V, err error) {
+	if err := s.validateEmpty(); err != nil {
+		return deletedValue, err
+	}
+	update := s.traverseList(key)
+
+	currentNode := update[0]
+	successorNode := currentNode.Next[0]
+
+	if successorNode == nil || s.compare(successorNode.Key, key) != 0 {
+		return deletedValue, ErrNotFound
+	}
+
+	deletedValue = successorNode.Value
+	for i := 0; i <= s.level; i++ {
+		if update[i].Next[i] == successorNode {
+			update[i].Span[i] += successorNode.Span[i] - 1
+			update[i].Next[i] = successorNode.Next[i]
+		} else {
+			update[i].Span[i]--
+		}
+	}
+
+	for s.level > 0 && s.head.Next[s.level] == nil {
+		s.level--
+	}
+
+	s.size--
+
+	return deletedValue, nil
+}
+
+// Insert implements OrderedMap.
+func (s *SkipList[K, V]) Insert(key K, value V) (oldValue V, replaced bool) {
+	update, rank := s.traverseWithRank(key)
+
+	currentNode := update[0]
+	successorNode := currentNode.Next[0]
+
+	if successorNode != nil && s.compare(successorNode.Key, key) == 0 {
+		oldValue = successorNode.Value
+		successorNode.Value = value
+		return oldValue, true
+	}
+
+	height := s.randomLevel()
+
+	newNode := &Node[K, V]{
+		Key:   key,
+		Value: value,
+		Next:  make([]*Node[K, V], height+1),
+		Span:  make([]int, height+1),
+	}
+
+	if s.level < height {
+		for i := s.level + 1; i <= height; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			update[i].Span[i] = s.size
+		}
+
+		s.level = height
+	}
+
+	for i := height; i >= 0; i-- {
+		newNode.Next[i] = update[i].Next[i]
+		update[i].Next[i] = newNode
+
+		newNode.Span[i] = update[i].Span[i] - (rank[0] - rank[i])
+		update[i].Span[i] = rank[0] - rank[i] + 1
+	}
+
+	for i := height + 1; i <= s.level; i++ {
+		update[i].Span[i]++
+	}
+
+	s.size++
+
+	return oldValue, false
+}
+
+// Search implements OrderedMap.
+func (s *SkipList[K, V]) Search(key K) (valueFound V, err error) {
+	if err := s.validateEmpty(); err != nil {
+		return valueFound, err
+	}
+
+	baseList := s.traverseList(key)
+	currentNode := baseList[0]
+
+	if currentNode.Next[0] != nil && s.compare(currentNode.Next[0].Key, key) == 0 {
+		return currentNode.Next[0].Value, nil
+	}
+
+	return valueFound, ErrNotFound
+}
+
+// Size implements OrderedMap.
+func (s *SkipList[K, V]) Size() int {
+	return s.size
+}
+
+// Range implements OrderedMap.
+func (s *SkipList[K, V]) Range(lo K, hi K, limit int) (entries []Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	if s.compare(lo, hi) > 0 {
+		return nil, ErrInvalidRange
+	}
+
+	entries = []Entry[K, V]{}
+	currentNode := s.traverseList(lo)[0].Next[0]
+	for currentNode != nil && s.compare(currentNode.Key, hi) <= 0 {
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+
+		entries = append(entries, currentNode.entry())
+		currentNode = currentNode.Next[0]
+	}
+
+	return entries, nil
+}
+
+// Floor implements OrderedMap.
+func (s *SkipList[K, V]) Floor(key K) (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	predecessorNode := s.traverseList(key)[0]
+	successorNode := predecessorNode.Next[0]
+
+	if successorNode != nil && s.compare(successorNode.Key, key) == 0 {
+		return successorNode.entry(), nil
+	}
+
+	if predecessorNode == s.head {
+		return entry, ErrNotFound
+	}
+
+	return predecessorNode.entry(), nil
+}
+
+// Ceiling implements OrderedMap.
+func (s *SkipList[K, V]) Ceiling(key K) (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	successorNode := s.traverseList(key)[0].Next[0]
+
+	if successorNode == nil {
+		return entry, ErrNotFound
+	}
+
+	return successorNode.entry(), nil
+}
+
+// First implements OrderedMap.
+func (s *SkipList[K, V]) First() (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	return s.head.Next[0].entry(), nil
+}
+
+// Last implements OrderedMap.
+func (s *SkipList[K, V]) Last() (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	currentNode := s.head
+	for i := s.level; i >= 0; i-- {
+		for currentNode.Next[i] != nil {
+			currentNode = currentNode.Next[i]
+		}
+	}
+
+	return currentNode.entry(), nil
+}
+
+// Next implements OrderedMap.
+func (s *SkipList[K, V]) Next(key K) (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	successorNode := s.traverseList(key)[0].Next[0]
+
+	if successorNode != nil && s.compare(successorNode.Key, key) == 0 {
+		successorNode = successorNode.Next[0]
+	}
+
+	if successorNode == nil {
+		return entry, ErrNotFound
+	}
+
+	return successorNode.entry(), nil
+}
+
+// Prev implements OrderedMap.
+func (s *SkipList[K, V]) Prev(key K) (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	predecessorNode := s.traverseList(key)[0]
+
+	if predecessorNode == s.head {
+		return entry, ErrNotFound
+	}
+
+	return predecessorNode.entry(), nil
+}
+
+// Iterate implements OrderedMap.
+// Forward iteration walks level 0 from the ceiling of from; backward iteration
+// has no Prev pointers to follow, so every step is a predecessor search.
+func (s *SkipList[K, V]) Iterate(from K, limit int, reverse bool) (entries []Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	entries = []Entry[K, V]{}
+
+	if !reverse {
+		currentNode := s.traverseList(from)[0].Next[0]
+		for currentNode != nil && (limit <= 0 || len(entries) < limit) {
+			entries = append(entries, currentNode.entry())
+			currentNode = currentNode.Next[0]
+		}
+
+		return entries, nil
+	}
+
+	entry, err := s.Floor(from)
+	for err == nil && (limit <= 0 || len(entries) < limit) {
+		entries = append(entries, entry)
+		entry, err = s.Prev(entry.Key)
+	}
+
+	return entries, nil
+}
+
+// Rank implements OrderedMap.
+// Ranks are zero based, so the first key has rank 0.
+func (s *SkipList[K, V]) Rank(key K) (rank int, err error) {
+	if err := s.validateEmpty(); err != nil {
+		return -1, err
+	}
+
+	traversed := 0
+	currentNode := s.head
+	for i := s.level; i >= 0; i-- {
+		for currentNode.Next[i] != nil && s.compare(currentNode.Next[i].Key, key) <= 0 {
+			traversed += currentNode.Span[i]
+			currentNode = currentNode.Next[i]
+		}
+
+		if currentNode != s.head && s.compare(currentNode.Key, key) == 0 {
+			return traversed - 1, nil
+		}
+	}
+
+	return -1, ErrNotFound
+}
+
+// GetByRank implements OrderedMap.
+func (s *SkipList[K, V]) GetByRank(rank int) (entry Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return entry, err
+	}
+
+	if rank < 0 || rank >= s.size {
+		return entry, ErrRankNotFound
+	}
+
+	return s.findByRank(rank).entry(), nil
+}
+
+// RangeByRank implements OrderedMap.
+// Both bounds are inclusive and negative values count from the end (-1 = last).
+func (s *SkipList[K, V]) RangeByRank(start int, stop int) (entries []Entry[K, V], err error) {
+	if err := s.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	if start < 0 {
+		start += s.size
+	}
+	if stop < 0 {
+		stop += s.size
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= s.size {
+		stop = s.size - 1
+	}
+
+	entries = []Entry[K, V]{}
+	if start > stop {
+		return entries, nil
+	}
+
+	currentNode := s.findByRank(start)
+	for i := start; i <= stop && currentNode != nil; i++ {
+		entries = append(entries, currentNode.entry())
+		currentNode = currentNode.Next[0]
+	}
+
+	return entries, nil
+}
+
+// Stats implements OrderedMap.
+// Search hops are averaged over up to sample keys spread evenly by rank.
+func (s *SkipList[K, V]) Stats(sample int) Stats {
+	if sample <= 0 {
+		sample = DefaultStatsSample
+	}
+
+	stats := Stats{
+		Size:          s.size,
+		Level:         s.level,
+		MaxLevel:      s.maxLevel,
+		Probability:   s.probability,
+		Seed:          s.seed,
+		NodesPerLevel: make([]int, s.level+1),
+	}
+
+	for i := 0; i <= s.level; i++ {
+		for currentNode := s.head.Next[i]; currentNode != nil; currentNode = currentNode.Next[i] {
+			stats.NodesPerLevel[i]++
+		}
+	}
+
+	if s.size == 0 {
+		return stats
+	}
+
+	if sample > s.size {
+		sample = s.size
+	}
+
+	totalHops := 0
+	for i := 0; i < sample; i++ {
+		key := s.findByRank(i * s.size / sample).Key
+		totalHops += s.searchHops(key)
+	}
+
+	stats.SampledKeys = sample
+	stats.AverageSearchHops = float64(totalHops) / float64(sample)
+
+	return stats
+}
+
+// Debug implements OrderedMap.
+func (s *SkipList[K, V]) Debug() Snapshot[K] {
+	snapshot := Snapshot[K]{
+		Diagram: make([]string, 0, s.level+1),
+		Levels:  make([]Level[K], 0, s.level+1),
+	}
+
+	labels := []string{}
+	heights := []int{}
+	for currentNode := s.head.Next[0]; currentNode != nil; currentNode = currentNode.Next[0] {
+		labels = append(labels, fmt.Sprint(currentNode.Key))
+		heights = append(heights, len(currentNode.Next))
+	}
+
+	for i := s.level; i >= 0; i-- {
+		snapshot.Diagram = append(snapshot.Diagram, renderLevel(i, labels, heights))
+		snapshot.Levels = append(snapshot.Levels, s.describeLevel(i))
+	}
+
+	return snapshot
+}
+
+/* Private Methods */
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 0
+
+	for s.rng.Float64() < s.probability && level < s.maxLevel-1 {
+		level++
+	}
+
+	return level
+}
+
+func (s *SkipList[K, V]) traverseList(key K) []*Node[K, V] {
+	update := make([]*Node[K, V], s.maxLevel)
+
+	currentNode := s.head
+	for i := s.level; i >= 0; i-- {
+		for currentNode.Next[i] != nil && s.compare(currentNode.Next[i].Key, key) < 0 {
+			currentNode = currentNode.Next[i]
+		}
+
+		update[i] = currentNode
+	}
+
+	return update
+}
+
+func (s *SkipList[K, V]) traverseWithRank(key K) ([]*Node[K, V], []int) {
+	update := make([]*Node[K, V], s.maxLevel)
+	rank := make([]int, s.maxLevel)
+
+	currentNode := s.head
+	for i := s.level; i >= 0; i-- {
+		if i < s.level {
+			rank[i] = rank[i+1]
+		}
+
+		for currentNode.Next[i] != nil && s.compare(currentNode.Next[i].Key, key) < 0 {
+			rank[i] += currentNode.Span[i]
+			currentNode = currentNode.Next[i]
+		}
+
+		update[i] = currentNode
+	}
+
+	return update, rank
+}
+
+// searchHops counts the forward pointers followed to reach key, including the
+// final step onto the node itself.
+func (s *SkipList[K, V]) searchHops(key K) int {
+	hops := 0
+
+	currentNode := s.head
+	for i := s.level; i >= 0; i-- {
+		for currentNode.Next[i] != nil && s.compare(currentNode.Next[i].Key, key) < 0 {
+			currentNode = currentNode.Next[i]
+			hops++
+		}
+	}
+
+	if currentNode.Next[0] != nil && s.compare(currentNode.Next[0].Key, key) == 0 {
+		hops++
+	}
+
+	return hops
+}
+
+func (s *SkipList[K, V]) findByRank(rank int) *Node[K, V] {
+	target := rank + 1
+	traversed := 0
+
+	currentNode := s.head
+	for i := s.level; i >= 0; i-- {
+		for currentNode.Next[i] != nil && traversed+currentNode.Span[i] <= target {
+			traversed += currentNode.Span[i]
+			currentNode = currentNode.Next[i]
+		}
+
+		if traversed == target {
+			return currentNode
+		}
+	}
+
+	return nil
+}
+
+func (s *SkipList[K, V]) describeLevel(level int) Level[K] {
+	described := Level[K]{Level: level, Nodes: []LevelNode[K]{}}
+
+	for currentNode := s.head.Next[level]; currentNode != nil; currentNode = currentNode.Next[level] {
+		levelNode := LevelNode[K]{Key: currentNode.Key, Span: currentNode.Span[level]}
+		if currentNode.Next[level] != nil {
+			nextKey := currentNode.Next[level].Key
+			levelNode.Next = &nextKey
+		}
+
+		described.Nodes = append(described.Nodes, levelNode)
+	}
+
+	return described
+}
+
+/* Validations */
+func (s *SkipList[K, V]) validateEmpty() error {
+	if s.size == 0 {
+		return ErrEmpty
+	}
+
+	return nil
+}
+
+/* Utils */
+func (n *Node[K, V]) entry() Entry[K, V] {
+	return Entry[K, V]{Key: n.Key, Value: n.Value}
+}
+
+func (n *Node[K, V]) String() string {
+	if n == nil {
+		return "<nil>"
+	}
+
+	keys := []string{}
+	for _, next := range n.Next {
+		if next != nil {
+			keys = append(keys, fmt.Sprint(next.Key))
+		} else {
+			keys = append(keys, "<nil>")
+		}
+	}
+
+	return fmt.Sprintf("Node{Key:%v, Value:%v, NextKeys:%v},", n.Key, n.Value, keys)
+}
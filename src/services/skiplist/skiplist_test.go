@@ -0,0 +1,112 @@
+package skiplist
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestSkipListSpansMatchSortedModel(t *testing.T) {
+	list := New[int, string](cmp.Compare[int])
+	list.Initialize(8, 0.5, 1)
+
+	var model []int
+	lowered := 0
+
+	r := rand.New(rand.NewSource(1))
+	for step := 0; step < 4000; step++ {
+		key := r.Intn(300)
+
+		// Grow for a while, then drain, so the list climbs to its top levels
+		// and has to give them back.
+		growing := step%1000 < 600
+		if r.Intn(4) != 0 == growing {
+			list.Insert(key, strconv.Itoa(key))
+			if index, found := slices.BinarySearch(model, key); !found {
+				model = slices.Insert(model, index, key)
+			}
+		} else if len(model) > 0 {
+			key = model[r.Intn(len(model))]
+			level := list.level
+			if _, err := list.Delete(key); err != nil {
+				t.Fatalf("step %d: Delete(%d) = %v", step, key, err)
+			}
+			index, _ := slices.BinarySearch(model, key)
+			model = slices.Delete(model, index, index+1)
+			if list.level < level {
+				lowered++
+			}
+		}
+
+		assertSpans(t, step, list, model)
+	}
+
+	if lowered == 0 {
+		t.Fatal("no delete lowered the list's level")
+	}
+}
+
+// assertSpans checks every span against the model as well as the rank
+// methods that read them.
+func assertSpans(t *testing.T, step int, list *SkipList[int, string], model []int) {
+	t.Helper()
+
+	if list.Size() != len(model) {
+		t.Fatalf("step %d: Size() = %d, want %d", step, list.Size(), len(model))
+	}
+
+	// Each pointer's span is the distance between the ranks it joins, and a
+	// trailing pointer's span counts the keys left after its node.
+	for level := 0; level <= list.level; level++ {
+		position := 0
+		for currentNode := list.head; currentNode != nil; currentNode = currentNode.Next[level] {
+			nextNode := currentNode.Next[level]
+
+			want := len(model) - position
+			if nextNode != nil {
+				index, _ := slices.BinarySearch(model, nextNode.Key)
+				want = index + 1 - position
+			}
+			if currentNode.Span[level] != want {
+				t.Fatalf("step %d: level %d span after rank %d = %d, want %d", step, level, position-1, currentNode.Span[level], want)
+			}
+
+			position += want
+		}
+	}
+	if list.level > 0 && list.head.Next[list.level] == nil {
+		t.Fatalf("step %d: level %d is empty but still in use", step, list.level)
+	}
+
+	if len(model) == 0 {
+		return
+	}
+
+	for rank, key := range model {
+		if got, err := list.Rank(key); err != nil || got != rank {
+			t.Fatalf("step %d: Rank(%d) = %d, %v, want %d", step, key, got, err, rank)
+		}
+		if entry, err := list.GetByRank(rank); err != nil || entry.Key != key {
+			t.Fatalf("step %d: GetByRank(%d) = %v, %v, want %d", step, rank, entry.Key, err, key)
+		}
+	}
+
+	start, stop := step%len(model), max(len(model)-1-step%3, 0)
+	entries, err := list.RangeByRank(start, stop)
+	if err != nil {
+		t.Fatalf("step %d: RangeByRank(%d, %d) = %v", step, start, stop, err)
+	}
+	keys := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, entry.Key)
+	}
+	want := []int{}
+	if start <= stop {
+		want = model[start : stop+1]
+	}
+	if !slices.Equal(keys, want) {
+		t.Fatalf("step %d: RangeByRank(%d, %d) = %v, want %v", step, start, stop, keys, want)
+	}
+}
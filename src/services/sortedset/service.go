@@ -0,0 +1,245 @@
+package sortedset
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"sync"
+
+	"golabs/src/services/skiplist"
+)
+
+var (
+	ErrEmpty        = errors.New("sorted set is empty")
+	ErrNotFound     = errors.New("sorted set member not found")
+	ErrInvalidRange = errors.New("sorted set score range is invalid")
+	ErrInvalidScore = errors.New("sorted set score must be finite")
+)
+
+type SortedSetService interface {
+	// Insertion Methods
+	ZAdd(member string, score float64) (added bool)
+	ZIncrBy(member string, increment float64) (newScore float64, err error)
+
+	// Accessibility Methods
+	ZScore(member string) (score float64, err error)
+	ZRank(member string) (rank int, err error)
+	ZRange(start int, stop int) (members []Member, err error)
+	ZRangeByScore(min float64, max float64, limit int) (members []Member, err error)
+	ZCard() int
+
+	// Deletion Methods
+	ZRem(member string) (score float64, err error)
+}
+
+type Member struct {
+	Member string
+	Score  float64
+}
+
+// scoreKey orders the skip list by score and breaks ties by member name, so
+// every member has a unique position and a stable rank.
+type scoreKey = skiplist.Tuple[float64, string]
+
+type sortedSet struct {
+	mu      sync.RWMutex
+	scores  map[string]float64
+	ordered *skiplist.SkipList[scoreKey, struct{}]
+}
+
+func NewSortedSet() SortedSetService {
+	return &sortedSet{
+		scores: map[string]float64{},
+		ordered: skiplist.New[scoreKey, struct{}](
+			skiplist.CompareTuples(cmp.Compare[float64], cmp.Compare[string]),
+		),
+	}
+}
+
+// ZAdd implements SortedSetService.
+// Adding an existing member moves it to its new score.
+func (z *sortedSet) ZAdd(member string, score float64) (added bool) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	return z.setScore(member, score)
+}
+
+// ZIncrBy implements SortedSetService.
+// A missing member is added with increment as its score. An increment that
+// would overflow to ±Inf is rejected and the old score is kept.
+func (z *sortedSet) ZIncrBy(member string, increment float64) (newScore float64, err error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	newScore = z.scores[member] + increment
+	if math.IsInf(newScore, 0) || math.IsNaN(newScore) {
+		return 0, ErrInvalidScore
+	}
+
+	z.setScore(member, newScore)
+
+	return newScore, nil
+}
+
+// ZScore implements SortedSetService.
+func (z *sortedSet) ZScore(member string) (score float64, err error) {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	score, found := z.scores[member]
+	if !found {
+		return 0, ErrNotFound
+	}
+
+	return score, nil
+}
+
+// ZRank implements SortedSetService.
+// Ranks are zero based and follow ascending score.
+func (z *sortedSet) ZRank(member string) (rank int, err error) {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	score, found := z.scores[member]
+	if !found {
+		return -1, ErrNotFound
+	}
+
+	return z.ordered.Rank(scoreKey{First: score, Second: member})
+}
+
+// ZRange implements SortedSetService.
+// Both bounds are inclusive and negative values count from the end (-1 = last).
+func (z *sortedSet) ZRange(start int, stop int) (members []Member, err error) {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	if err := z.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	entries, err := z.ordered.RangeByRank(start, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMembers(entries), nil
+}
+
+// ZRangeByScore implements SortedSetService.
+// Both bounds are inclusive and may be ±Inf; limit <= 0 returns every match.
+func (z *sortedSet) ZRangeByScore(min float64, max float64, limit int) (members []Member, err error) {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	if err := z.validateEmpty(); err != nil {
+		return nil, err
+	}
+
+	// NaN compares false against everything, so it would slip past min > max.
+	if math.IsNaN(min) || math.IsNaN(max) || min > max {
+		return nil, ErrInvalidRange
+	}
+
+	// The empty member sorts first among equal scores, so (min, "") is the
+	// lowest possible key for min and the predecessor of (next score, "") is
+	// the highest key scoring at most max.
+	first, err := z.ordered.Ceiling(scoreKey{First: min})
+	if errors.Is(err, skiplist.ErrNotFound) {
+		return []Member{}, nil
+	}
+
+	// Nextafter(+Inf) is +Inf itself, which would shut out members scored
+	// +Inf, so an unbounded max runs to the last member instead.
+	var last skiplist.Entry[scoreKey, struct{}]
+	if math.IsInf(max, 1) {
+		last, err = z.ordered.Last()
+	} else {
+		last, err = z.ordered.Prev(scoreKey{First: math.Nextafter(max, math.Inf(1))})
+	}
+	if errors.Is(err, skiplist.ErrNotFound) {
+		return []Member{}, nil
+	}
+
+	start, _ := z.ordered.Rank(first.Key)
+	stop, _ := z.ordered.Rank(last.Key)
+
+	if start > stop {
+		return []Member{}, nil
+	}
+
+	if limit > 0 && stop-start+1 > limit {
+		stop = start + limit - 1
+	}
+
+	entries, err := z.ordered.RangeByRank(start, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMembers(entries), nil
+}
+
+// ZCard implements SortedSetService.
+func (z *sortedSet) ZCard() int {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	return len(z.scores)
+}
+
+// ZRem implements SortedSetService.
+func (z *sortedSet) ZRem(member string) (score float64, err error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	score, found := z.scores[member]
+	if !found {
+		return 0, ErrNotFound
+	}
+
+	z.ordered.Delete(scoreKey{First: score, Second: member})
+	delete(z.scores, member)
+
+	return score, nil
+}
+
+/* Private Methods */
+
+func (z *sortedSet) setScore(member string, score float64) (added bool) {
+	oldScore, found := z.scores[member]
+	if found {
+		if oldScore == score {
+			return false
+		}
+
+		z.ordered.Delete(scoreKey{First: oldScore, Second: member})
+	}
+
+	z.scores[member] = score
+	z.ordered.Insert(scoreKey{First: score, Second: member}, struct{}{})
+
+	return !found
+}
+
+/* Validations */
+
+func (z *sortedSet) validateEmpty() error {
+	if len(z.scores) == 0 {
+		return ErrEmpty
+	}
+
+	return nil
+}
+
+/* Utils */
+
+func toMembers(entries []skiplist.Entry[scoreKey, struct{}]) []Member {
+	members := make([]Member, 0, len(entries))
+	for _, entry := range entries {
+		members = append(members, Member{Member: entry.Key.Second, Score: entry.Key.First})
+	}
+
+	return members
+}
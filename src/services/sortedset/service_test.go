@@ -0,0 +1,161 @@
+package sortedset
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// sortedModel lists the model's members the way the set orders them: by
+// score, then by member name.
+func sortedModel(model map[string]float64) []Member {
+	members := make([]Member, 0, len(model))
+	for member, score := range model {
+		members = append(members, Member{Member: member, Score: score})
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		if c := cmp.Compare(a.Score, b.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Member, b.Member)
+	})
+
+	return members
+}
+
+func TestSortedSetMatchesMapModel(t *testing.T) {
+	z := NewSortedSet()
+	model := map[string]float64{}
+
+	r := rand.New(rand.NewSource(1))
+	for step := 0; step < 3000; step++ {
+		// Few members and few distinct scores, so ties are common.
+		member := "m" + strconv.Itoa(r.Intn(40))
+		score := float64(r.Intn(10))
+
+		switch r.Intn(3) {
+		case 0:
+			_, existed := model[member]
+			if added := z.ZAdd(member, score); added == existed {
+				t.Fatalf("step %d: ZAdd(%q) = %v, want %v", step, member, added, !existed)
+			}
+			model[member] = score
+		case 1:
+			newScore, err := z.ZIncrBy(member, score-5)
+			if err != nil || newScore != model[member]+score-5 {
+				t.Fatalf("step %d: ZIncrBy(%q, %v) = %v, %v, want %v", step, member, score-5, newScore, err, model[member]+score-5)
+			}
+			model[member] = newScore
+		case 2:
+			removed, err := z.ZRem(member)
+			want, found := model[member]
+			if !found {
+				if err != ErrNotFound {
+					t.Fatalf("step %d: ZRem(%q) of a missing member = %v, want %v", step, member, err, ErrNotFound)
+				}
+				continue
+			}
+			if err != nil || removed != want {
+				t.Fatalf("step %d: ZRem(%q) = %v, %v, want %v", step, member, removed, err, want)
+			}
+			delete(model, member)
+		}
+
+		want := sortedModel(model)
+		if z.ZCard() != len(want) {
+			t.Fatalf("step %d: ZCard() = %d, want %d", step, z.ZCard(), len(want))
+		}
+		if len(want) == 0 {
+			continue
+		}
+
+		got, err := z.ZRange(0, -1)
+		if err != nil || !slices.Equal(got, want) {
+			t.Fatalf("step %d: ZRange(0, -1) = %v, %v, want %v", step, got, err, want)
+		}
+
+		for rank, entry := range want {
+			if got, err := z.ZRank(entry.Member); err != nil || got != rank {
+				t.Fatalf("step %d: ZRank(%q) = %d, %v, want %d", step, entry.Member, got, err, rank)
+			}
+		}
+	}
+}
+
+func TestSortedSetRankBreaksTiesByMember(t *testing.T) {
+	z := NewSortedSet()
+	z.ZAdd("carol", 1)
+	z.ZAdd("alice", 1)
+	z.ZAdd("bob", 1)
+	z.ZAdd("dave", 0)
+
+	for member, want := range map[string]int{"dave": 0, "alice": 1, "bob": 2, "carol": 3} {
+		if rank, err := z.ZRank(member); err != nil || rank != want {
+			t.Fatalf("ZRank(%q) = %d, %v, want %d", member, rank, err, want)
+		}
+	}
+}
+
+func TestSortedSetRangeByScore(t *testing.T) {
+	z := NewSortedSet()
+	z.ZAdd("a", 1)
+	z.ZAdd("b", 2)
+	z.ZAdd("c", 2)
+	z.ZAdd("d", 3)
+	z.ZAdd("e", 5)
+	z.ZAdd("f", math.Inf(1))
+
+	tests := []struct {
+		name     string
+		min, max float64
+		limit    int
+		want     []string
+	}{
+		{"inclusive bounds", 2, 3, 0, []string{"b", "c", "d"}},
+		{"single score", 2, 2, 0, []string{"b", "c"}},
+		{"between scores", 3.5, 4.5, 0, []string{}},
+		{"unbounded", math.Inf(-1), math.Inf(1), 0, []string{"a", "b", "c", "d", "e", "f"}},
+		{"unbounded max", 3, math.Inf(1), 0, []string{"d", "e", "f"}},
+		{"finite max below +Inf", 5, math.MaxFloat64, 0, []string{"e"}},
+		{"unbounded min", math.Inf(-1), 1, 0, []string{"a"}},
+		{"limit", 1, 5, 2, []string{"a", "b"}},
+		{"limit past the matches", 5, 5, 3, []string{"e"}},
+	}
+
+	for _, tt := range tests {
+		members, err := z.ZRangeByScore(tt.min, tt.max, tt.limit)
+		if err != nil {
+			t.Fatalf("%s: ZRangeByScore(%v, %v, %d) = %v", tt.name, tt.min, tt.max, tt.limit, err)
+		}
+
+		got := make([]string, 0, len(members))
+		for _, member := range members {
+			got = append(got, member.Member)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("%s: ZRangeByScore(%v, %v, %d) = %q, want %q", tt.name, tt.min, tt.max, tt.limit, got, tt.want)
+		}
+	}
+
+	for _, bounds := range [][2]float64{{3, 2}, {math.NaN(), 2}, {1, math.NaN()}} {
+		if _, err := z.ZRangeByScore(bounds[0], bounds[1], 0); err != ErrInvalidRange {
+			t.Fatalf("ZRangeByScore(%v, %v) = %v, want %v", bounds[0], bounds[1], err, ErrInvalidRange)
+		}
+	}
+}
+
+func TestSortedSetIncrByRejectsOverflow(t *testing.T) {
+	z := NewSortedSet()
+	z.ZAdd("a", math.MaxFloat64)
+
+	if _, err := z.ZIncrBy("a", math.MaxFloat64); err != ErrInvalidScore {
+		t.Fatalf("ZIncrBy() past MaxFloat64 = %v, want %v", err, ErrInvalidScore)
+	}
+	if score, _ := z.ZScore("a"); score != math.MaxFloat64 {
+		t.Fatalf("ZScore() after a rejected increment = %v, want %v", score, math.MaxFloat64)
+	}
+}
@@ -0,0 +1,142 @@
+package stack
+
+import (
+	"cmp"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrNotNumeric = errors.New("stack value is not numeric")
+
+type Order string
+
+const (
+	OrderLexical Order = "lexical"
+	OrderNumeric Order = "numeric"
+)
+
+// MinMaxStackService is a StackService that also answers Min and Max in
+// O(1).
+type MinMaxStackService interface {
+	StackService
+	Min() (string, error)
+	Max() (string, error)
+	Order() Order
+}
+
+// minMaxStack pushes the running minimum and maximum onto two shadow stacks
+// alongside every value, so the extremes for any height are always on top
+// and a pop restores the previous ones for free. The shadow stacks share the
+// value stack's policy, so they grow and shrink with it.
+type minMaxStack struct {
+	StackService
+	mins  StackService
+	maxs  StackService
+	order Order
+}
+
+// NewMinMaxStackService compares values lexically unless order is
+// OrderNumeric, in which case only numbers can be pushed.
+func NewMinMaxStackService(order Order) MinMaxStackService {
+	if order != OrderNumeric {
+		order = OrderLexical
+	}
+
+	return &minMaxStack{
+		StackService: NewStackService(),
+		mins:         NewStackService(),
+		maxs:         NewStackService(),
+		order:        order,
+	}
+}
+
+func (s *minMaxStack) Initialize(capacity int, policy Policy) error {
+	if err := s.StackService.Initialize(capacity, policy); err != nil {
+		return err
+	}
+
+	s.mins.Initialize(capacity, policy)
+	s.maxs.Initialize(capacity, policy)
+
+	return nil
+}
+
+func (s *minMaxStack) Push(value string) error {
+	if err := s.validateValue(value); err != nil {
+		return err
+	}
+
+	if err := s.StackService.Push(value); err != nil {
+		return err
+	}
+
+	lowest, highest := value, value
+	if currentMin, err := s.mins.Peek(); err == nil {
+		currentMax, _ := s.maxs.Peek()
+
+		if s.compare(currentMin, lowest) < 0 {
+			lowest = currentMin
+		}
+		if s.compare(currentMax, highest) > 0 {
+			highest = currentMax
+		}
+	}
+
+	s.mins.Push(lowest)
+	s.maxs.Push(highest)
+
+	return nil
+}
+
+func (s *minMaxStack) Pop() (string, error) {
+	value, err := s.StackService.Pop()
+	if err != nil {
+		return "", err
+	}
+
+	s.mins.Pop()
+	s.maxs.Pop()
+
+	return value, nil
+}
+
+func (s *minMaxStack) Min() (string, error) {
+	return s.mins.Peek()
+}
+
+func (s *minMaxStack) Max() (string, error) {
+	return s.maxs.Peek()
+}
+
+func (s *minMaxStack) Order() Order {
+	return s.order
+}
+
+/* Private Methods */
+
+// compare only sees values that passed validateValue, so numeric parsing
+// cannot fail here.
+func (s *minMaxStack) compare(a string, b string) int {
+	if s.order == OrderNumeric {
+		x, _ := strconv.ParseFloat(a, 64)
+		y, _ := strconv.ParseFloat(b, 64)
+		return cmp.Compare(x, y)
+	}
+
+	return strings.Compare(a, b)
+}
+
+/* Validations */
+
+func (s *minMaxStack) validateValue(value string) error {
+	if s.order != OrderNumeric {
+		return nil
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return ErrNotNumeric
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package stack
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func newMinMax(t *testing.T, order Order, values ...string) MinMaxStackService {
+	t.Helper()
+
+	s := NewMinMaxStackService(order)
+	if err := s.Initialize(2, Policy{Mode: ModeGrowable, Shrink: true}); err != nil {
+		t.Fatalf("Initialize() = %v", err)
+	}
+	for _, value := range values {
+		if err := s.Push(value); err != nil {
+			t.Fatalf("Push(%q) = %v", value, err)
+		}
+	}
+
+	return s
+}
+
+func assertExtremes(t *testing.T, s MinMaxStackService, lowest string, highest string) {
+	t.Helper()
+
+	if value, err := s.Min(); err != nil || value != lowest {
+		t.Fatalf("Min() = %q, %v, want %q", value, err, lowest)
+	}
+	if value, err := s.Max(); err != nil || value != highest {
+		t.Fatalf("Max() = %q, %v, want %q", value, err, highest)
+	}
+}
+
+func TestMinMaxOrder(t *testing.T) {
+	values := []string{"9", "10", "2", "-3"}
+
+	assertExtremes(t, newMinMax(t, OrderLexical, values...), "-3", "9")
+	assertExtremes(t, newMinMax(t, OrderNumeric, values...), "-3", "10")
+
+	// 10 and 1e1 are equal numerically, so the later push is reported until
+	// it is popped.
+	s := newMinMax(t, OrderNumeric, "1e1", "10")
+	assertExtremes(t, s, "10", "10")
+	s.Pop()
+	assertExtremes(t, s, "1e1", "1e1")
+
+	if order := NewMinMaxStackService("").Order(); order != OrderLexical {
+		t.Fatalf("Order() by default = %q, want %q", order, OrderLexical)
+	}
+}
+
+func TestMinMaxAfterPops(t *testing.T) {
+	s := newMinMax(t, OrderNumeric, "5", "3", "8", "1", "9")
+
+	want := [][2]string{{"1", "8"}, {"3", "8"}, {"3", "5"}, {"5", "5"}}
+	for _, extremes := range want {
+		s.Pop()
+		assertExtremes(t, s, extremes[0], extremes[1])
+	}
+
+	s.Pop()
+	if _, err := s.Min(); err != ErrEmpty {
+		t.Fatalf("Min() on empty stack = %v, want %v", err, ErrEmpty)
+	}
+	if _, err := s.Max(); err != ErrEmpty {
+		t.Fatalf("Max() on empty stack = %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestMinMaxDuplicateExtremes(t *testing.T) {
+	s := newMinMax(t, OrderLexical, "b", "a", "c", "a", "c")
+
+	// Popping one copy of an extreme leaves the other in charge.
+	s.Pop()
+	assertExtremes(t, s, "a", "c")
+	s.Pop()
+	assertExtremes(t, s, "a", "c")
+	s.Pop()
+	assertExtremes(t, s, "a", "b")
+	s.Pop()
+	assertExtremes(t, s, "b", "b")
+}
+
+func TestMinMaxRejectsNonNumericValues(t *testing.T) {
+	s := newMinMax(t, OrderNumeric, "4")
+
+	if err := s.Push("four"); err != ErrNotNumeric {
+		t.Fatalf("Push(%q) = %v, want %v", "four", err, ErrNotNumeric)
+	}
+	if size, _ := s.Size(); size != 1 {
+		t.Fatalf("Size() after a rejected push = %d, want 1", size)
+	}
+	assertExtremes(t, s, "4", "4")
+}
+
+func TestMinMaxMatchesSliceModel(t *testing.T) {
+	s := newMinMax(t, OrderNumeric)
+
+	var model []float64
+	r := rand.New(rand.NewSource(1))
+	for step := 0; step < 2000; step++ {
+		if r.Intn(3) != 0 || len(model) == 0 {
+			value := float64(r.Intn(200) - 100)
+			s.Push(strconv.FormatFloat(value, 'f', -1, 64))
+			model = append(model, value)
+		} else {
+			s.Pop()
+			model = model[:len(model)-1]
+		}
+
+		if len(model) == 0 {
+			continue
+		}
+
+		lowest, _ := s.Min()
+		highest, _ := s.Max()
+		wantLowest := strconv.FormatFloat(slices.Min(model), 'f', -1, 64)
+		wantHighest := strconv.FormatFloat(slices.Max(model), 'f', -1, 64)
+		if lowest != wantLowest || highest != wantHighest {
+			t.Fatalf("step %d: Min(), Max() = %q, %q, want %q, %q", step, lowest, highest, wantLowest, wantHighest)
+		}
+	}
+}
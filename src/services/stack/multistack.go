@@ -0,0 +1,194 @@
+package stack
+
+import "errors"
+
+var (
+	ErrInvalidLayout = errors.New("multi-stack needs at least one stack and one slot")
+	ErrUnknownStack  = errors.New("multi-stack index out of range")
+)
+
+type MultiStackStats struct {
+	Stacks   int
+	Capacity int
+	Used     int
+	Free     int
+	Sizes    []int
+	// Writes counts every entry written to the shared arrays: the value and
+	// its link on a push, the free-list link on a pop.
+	Writes     int
+	Operations int
+	// AmortizedCost is Writes per push or pop. It stays constant however the
+	// slots are spread across the stacks, because nothing is ever shifted.
+	AmortizedCost float64
+}
+
+// MultiStackService keeps k stacks in one backing array. Any stack may use
+// any free slot, so the array is only full once every slot is taken.
+type MultiStackService interface {
+	Initialize(stacks int, capacity int) error
+	Push(stack int, value string) error
+	Pop(stack int) (string, error)
+	Peek(stack int) (string, error)
+	Size(stack int) (int, error)
+	Stats() (MultiStackStats, error)
+}
+
+// multiStack threads the stacks through the slots with a parallel next
+// array: next[slot] is the slot below it in its stack, or the next free slot
+// when it is unused. tops and free are the heads of those chains, -1 when
+// empty.
+type multiStack struct {
+	values     []string
+	next       []int
+	tops       []int
+	sizes      []int
+	free       int
+	used       int
+	writes     int
+	operations int
+}
+
+func NewMultiStackService() MultiStackService {
+	return &multiStack{}
+}
+
+func (s *multiStack) Initialize(stacks int, capacity int) error {
+	if stacks < 1 || capacity < 1 {
+		return ErrInvalidLayout
+	}
+
+	*s = multiStack{
+		values: make([]string, capacity),
+		next:   make([]int, capacity),
+		tops:   make([]int, stacks),
+		sizes:  make([]int, stacks),
+	}
+
+	for slot := range s.next {
+		s.next[slot] = slot + 1
+	}
+	s.next[capacity-1] = -1
+
+	for stack := range s.tops {
+		s.tops[stack] = -1
+	}
+
+	return nil
+}
+
+func (s *multiStack) Push(stack int, value string) error {
+	if err := s.validateStack(stack); err != nil {
+		return err
+	}
+
+	if s.free == -1 {
+		return ErrFull
+	}
+
+	slot := s.free
+	s.free = s.next[slot]
+
+	s.values[slot] = value
+	s.next[slot] = s.tops[stack]
+	s.tops[stack] = slot
+
+	s.sizes[stack]++
+	s.used++
+	s.writes += 2
+	s.operations++
+
+	return nil
+}
+
+func (s *multiStack) Pop(stack int) (string, error) {
+	if err := s.validateNotEmpty(stack); err != nil {
+		return "", err
+	}
+
+	slot := s.tops[stack]
+	value := s.values[slot]
+
+	s.tops[stack] = s.next[slot]
+	s.values[slot] = ""
+	s.next[slot] = s.free
+	s.free = slot
+
+	s.sizes[stack]--
+	s.used--
+	s.writes++
+	s.operations++
+
+	return value, nil
+}
+
+func (s *multiStack) Peek(stack int) (string, error) {
+	if err := s.validateNotEmpty(stack); err != nil {
+		return "", err
+	}
+
+	return s.values[s.tops[stack]], nil
+}
+
+func (s *multiStack) Size(stack int) (int, error) {
+	if err := s.validateStack(stack); err != nil {
+		return 0, err
+	}
+
+	return s.sizes[stack], nil
+}
+
+func (s *multiStack) Stats() (MultiStackStats, error) {
+	if err := s.validateInitialized(); err != nil {
+		return MultiStackStats{}, err
+	}
+
+	stats := MultiStackStats{
+		Stacks:     len(s.tops),
+		Capacity:   len(s.values),
+		Used:       s.used,
+		Free:       len(s.values) - s.used,
+		Sizes:      append([]int(nil), s.sizes...),
+		Writes:     s.writes,
+		Operations: s.operations,
+	}
+
+	if s.operations > 0 {
+		stats.AmortizedCost = float64(s.writes) / float64(s.operations)
+	}
+
+	return stats, nil
+}
+
+/* Validations */
+
+func (s *multiStack) validateInitialized() error {
+	if s.values == nil {
+		return ErrUninitialized
+	}
+
+	return nil
+}
+
+func (s *multiStack) validateStack(stack int) error {
+	if err := s.validateInitialized(); err != nil {
+		return err
+	}
+
+	if stack < 0 || stack >= len(s.tops) {
+		return ErrUnknownStack
+	}
+
+	return nil
+}
+
+func (s *multiStack) validateNotEmpty(stack int) error {
+	if err := s.validateStack(stack); err != nil {
+		return err
+	}
+
+	if s.tops[stack] == -1 {
+		return ErrEmpty
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package stack
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMultiStackReusesFreedSlots(t *testing.T) {
+	s := NewMultiStackService()
+	s.Initialize(3, 4)
+
+	s.Push(0, "a")
+	s.Push(0, "b")
+	s.Push(1, "c")
+	s.Push(2, "d")
+
+	if err := s.Push(1, "x"); err != ErrFull {
+		t.Fatalf("Push() with every slot taken = %v, want %v", err, ErrFull)
+	}
+
+	// The slot stack 0 gives back is the only free one, so stack 2 has to
+	// get it.
+	if value, err := s.Pop(0); err != nil || value != "b" {
+		t.Fatalf("Pop(0) = %q, %v, want %q", value, err, "b")
+	}
+	if err := s.Push(2, "e"); err != nil {
+		t.Fatalf("Push(2) into a freed slot = %v", err)
+	}
+	if err := s.Push(0, "x"); err != ErrFull {
+		t.Fatalf("Push() after reusing the freed slot = %v, want %v", err, ErrFull)
+	}
+
+	for stack, want := range [][]string{{"a"}, {"c"}, {"e", "d"}} {
+		for _, value := range want {
+			if got, err := s.Pop(stack); err != nil || got != value {
+				t.Fatalf("Pop(%d) = %q, %v, want %q", stack, got, err, value)
+			}
+		}
+	}
+
+	stats, _ := s.Stats()
+	if stats.Used != 0 || stats.Free != 4 || !slices.Equal(stats.Sizes, []int{0, 0, 0}) {
+		t.Fatalf("Stats() after draining = %+v, want every slot free", stats)
+	}
+	// Five pushes at two writes and five pops at one; rejected pushes write
+	// nothing.
+	if stats.Writes != 15 || stats.Operations != 10 || stats.AmortizedCost != 1.5 {
+		t.Fatalf("Stats() = %+v, want 15 writes over 10 operations", stats)
+	}
+}
+
+func TestMultiStackOneStackCanTakeEverySlot(t *testing.T) {
+	s := NewMultiStackService()
+	s.Initialize(3, 5)
+
+	for i := 0; i < 5; i++ {
+		if err := s.Push(1, strconv.Itoa(i)); err != nil {
+			t.Fatalf("Push(1, %d) = %v", i, err)
+		}
+	}
+
+	for _, stack := range []int{0, 1, 2} {
+		if err := s.Push(stack, "x"); err != ErrFull {
+			t.Fatalf("Push(%d) with one stack holding every slot = %v, want %v", stack, err, ErrFull)
+		}
+	}
+	if _, err := s.Pop(0); err != ErrEmpty {
+		t.Fatalf("Pop(0) of an empty stack = %v, want %v", err, ErrEmpty)
+	}
+	if _, err := s.Pop(3); err != ErrUnknownStack {
+		t.Fatalf("Pop(3) = %v, want %v", err, ErrUnknownStack)
+	}
+}
+
+func TestMultiStackMatchesSliceModel(t *testing.T) {
+	const stacks, capacity = 4, 12
+
+	s := NewMultiStackService()
+	s.Initialize(stacks, capacity)
+	model := make([][]string, stacks)
+	used := 0
+
+	r := rand.New(rand.NewSource(1))
+	for step := 0; step < 3000; step++ {
+		stack := r.Intn(stacks)
+
+		if r.Intn(2) == 0 {
+			value := strconv.Itoa(step)
+			err := s.Push(stack, value)
+			if used == capacity {
+				if err != ErrFull {
+					t.Fatalf("step %d: Push(%d) when full = %v, want %v", step, stack, err, ErrFull)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("step %d: Push(%d) = %v", step, stack, err)
+			}
+			model[stack] = append(model[stack], value)
+			used++
+		} else {
+			value, err := s.Pop(stack)
+			if len(model[stack]) == 0 {
+				if err != ErrEmpty {
+					t.Fatalf("step %d: Pop(%d) when empty = %v, want %v", step, stack, err, ErrEmpty)
+				}
+				continue
+			}
+			if want := model[stack][len(model[stack])-1]; err != nil || value != want {
+				t.Fatalf("step %d: Pop(%d) = %q, %v, want %q", step, stack, value, err, want)
+			}
+			model[stack] = model[stack][:len(model[stack])-1]
+			used--
+		}
+
+		stats, _ := s.Stats()
+		if stats.Used != used || stats.Free != capacity-used {
+			t.Fatalf("step %d: Stats() = %+v, want %d used", step, stats, used)
+		}
+		for i := range model {
+			if stats.Sizes[i] != len(model[i]) {
+				t.Fatalf("step %d: Sizes[%d] = %d, want %d", step, i, stats.Sizes[i], len(model[i]))
+			}
+		}
+	}
+}
@@ -6,35 +6,107 @@ var (
 	ErrUninitialized = errors.New("stack is not initialized")
 	ErrEmpty         = errors.New("stack is empty")
 	ErrFull          = errors.New("stack is full")
+	ErrInvalidPolicy = errors.New("stack policy is invalid")
 )
 
+type Mode string
+
+const (
+	// ModeBounded keeps the capacity fixed and rejects pushes once full.
+	ModeBounded Mode = "bounded"
+	// ModeGrowable resizes the backing array instead of returning ErrFull.
+	ModeGrowable Mode = "growable"
+)
+
+type Growth string
+
+const (
+	GrowthDoubling    Growth = "2x"
+	GrowthOneAndAHalf Growth = "1.5x"
+)
+
+const (
+	defaultCapacity    = 10
+	shrinkBelowDivisor = 4
+)
+
+// Policy picks how the stack reacts to running out of room. The zero value
+// is the bounded stack.
+type Policy struct {
+	Mode   Mode
+	Growth Growth
+	// Shrink halves a growable stack when it falls below a quarter full. It
+	// never shrinks below the initial capacity.
+	Shrink bool
+}
+
+type Stats struct {
+	Policy   Policy
+	Capacity int
+	Size     int
+	Grows    int
+	Shrinks  int
+	// Copies counts the elements moved by resizes.
+	Copies     int
+	Operations int
+	// AmortizedCost is the number of element writes per push or pop,
+	// including the copies made by resizes.
+	AmortizedCost float64
+}
+
 type StackService interface {
-	Initialize(capacity int)
+	Initialize(capacity int, policy Policy) error
 	Push(value string) error
 	Pop() (string, error)
 	Peek() (string, error)
 	Size() (int, error)
 	IsEmpty() (bool, error)
+	ToSlice() ([]string, error)
+	Stats() (Stats, error)
 }
 
 type stack struct {
-	elements []string
-	top      int
-	capacity int
+	elements        []string
+	top             int
+	capacity        int
+	initialCapacity int
+	policy          Policy
+	grows           int
+	shrinks         int
+	copies          int
+	operations      int
 }
 
 func NewStackService() StackService {
 	return &stack{}
 }
 
-func (s *stack) Initialize(capacity int) {
+// NewGrowable returns a stack initialized in ModeGrowable with the default
+// capacity, for callers that only need somewhere to push.
+func NewGrowable() StackService {
+	service := NewStackService()
+	service.Initialize(0, Policy{Mode: ModeGrowable})
+	return service
+}
+
+func (s *stack) Initialize(capacity int, policy Policy) error {
+	if err := validatePolicy(&policy); err != nil {
+		return err
+	}
+
 	if capacity <= 0 {
-		capacity = 10
+		capacity = defaultCapacity
 	}
 
-	s.elements = make([]string, capacity)
-	s.top = -1
-	s.capacity = capacity
+	*s = stack{
+		elements:        make([]string, capacity),
+		top:             -1,
+		capacity:        capacity,
+		initialCapacity: capacity,
+		policy:          policy,
+	}
+
+	return nil
 }
 
 func (s *stack) Push(value string) error {
@@ -43,8 +115,14 @@ func (s *stack) Push(value string) error {
 		return err
 	}
 
+	if s.top == s.capacity-1 {
+		s.resize(s.grownCapacity())
+		s.grows++
+	}
+
 	s.top++
 	s.elements[s.top] = value
+	s.operations++
 	return nil
 }
 
@@ -57,6 +135,12 @@ func (s *stack) Pop() (string, error) {
 	value := s.elements[s.top]
 	s.elements[s.top] = ""
 	s.top--
+	s.operations++
+
+	if s.shouldShrink() {
+		s.resize(max(s.capacity/2, s.initialCapacity))
+		s.shrinks++
+	}
 
 	return value, nil
 }
@@ -84,6 +168,96 @@ func (s *stack) IsEmpty() (bool, error) {
 	return s.top == -1, nil
 }
 
+// ToSlice returns the elements from the bottom of the stack to the top.
+func (s *stack) ToSlice() ([]string, error) {
+	if err := s.validateInitialized(); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, s.top+1)
+	copy(values, s.elements)
+
+	return values, nil
+}
+
+func (s *stack) Stats() (Stats, error) {
+	if err := s.validateInitialized(); err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{
+		Policy:     s.policy,
+		Capacity:   s.capacity,
+		Size:       s.top + 1,
+		Grows:      s.grows,
+		Shrinks:    s.shrinks,
+		Copies:     s.copies,
+		Operations: s.operations,
+	}
+
+	if s.operations > 0 {
+		stats.AmortizedCost = float64(s.operations+s.copies) / float64(s.operations)
+	}
+
+	return stats, nil
+}
+
+/* Private Methods */
+
+func (s *stack) grownCapacity() int {
+	if s.policy.Growth == GrowthOneAndAHalf {
+		return s.capacity + max(s.capacity/2, 1)
+	}
+
+	return s.capacity * 2
+}
+
+// shouldShrink waits until the stack is a quarter full rather than half, so
+// a push right after a shrink never triggers a grow straight away.
+func (s *stack) shouldShrink() bool {
+	return s.policy.Shrink &&
+		s.capacity > s.initialCapacity &&
+		s.top+1 < s.capacity/shrinkBelowDivisor
+}
+
+func (s *stack) resize(capacity int) {
+	elements := make([]string, capacity)
+	s.copies += copy(elements, s.elements[:s.top+1])
+
+	s.elements = elements
+	s.capacity = capacity
+}
+
+/* Validations */
+
+// validatePolicy fills in the defaults of a partially set policy.
+func validatePolicy(policy *Policy) error {
+	if policy.Mode == "" {
+		policy.Mode = ModeBounded
+	}
+
+	if policy.Mode != ModeBounded && policy.Mode != ModeGrowable {
+		return ErrInvalidPolicy
+	}
+
+	if policy.Mode == ModeBounded {
+		if policy.Growth != "" || policy.Shrink {
+			return ErrInvalidPolicy
+		}
+		return nil
+	}
+
+	if policy.Growth == "" {
+		policy.Growth = GrowthDoubling
+	}
+
+	if policy.Growth != GrowthDoubling && policy.Growth != GrowthOneAndAHalf {
+		return ErrInvalidPolicy
+	}
+
+	return nil
+}
+
 func (s *stack) validateInitialized() error {
 	if s.elements == nil || s.capacity == 0 {
 		return ErrUninitialized
@@ -97,7 +271,7 @@ func (s *stack) validateForPush() error {
 		return err
 	}
 
-	if s.top >= s.capacity-1 {
+	if s.policy.Mode == ModeBounded && s.top >= s.capacity-1 {
 		return ErrFull
 	}
 	return nil
@@ -0,0 +1,121 @@
+package stack
+
+import (
+	"strconv"
+	"testing"
+)
+
+func pushN(t *testing.T, s StackService, n int) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		if err := s.Push(strconv.Itoa(i)); err != nil {
+			t.Fatalf("Push(%d) = %v", i, err)
+		}
+	}
+}
+
+func TestGrowthPolicies(t *testing.T) {
+	tests := []struct {
+		growth   Growth
+		capacity int
+		grows    int
+		copies   int
+		cost     float64
+	}{
+		// 4 -> 8 -> 16 -> 32 copies 4 + 8 + 16 elements.
+		{GrowthDoubling, 32, 3, 28, 2.4},
+		// 4 -> 6 -> 9 -> 13 -> 19 -> 28 copies 4 + 6 + 9 + 13 + 19 elements.
+		{GrowthOneAndAHalf, 28, 5, 51, 3.55},
+	}
+
+	for _, tt := range tests {
+		s := NewStackService()
+		if err := s.Initialize(4, Policy{Mode: ModeGrowable, Growth: tt.growth}); err != nil {
+			t.Fatalf("%s: Initialize() = %v", tt.growth, err)
+		}
+		pushN(t, s, 20)
+
+		stats, _ := s.Stats()
+		if stats.Capacity != tt.capacity || stats.Grows != tt.grows || stats.Copies != tt.copies {
+			t.Fatalf("%s: Stats() = %+v, want capacity %d, %d grows and %d copies", tt.growth, stats, tt.capacity, tt.grows, tt.copies)
+		}
+		if stats.Operations != 20 || stats.AmortizedCost != tt.cost {
+			t.Fatalf("%s: Stats() = %+v, want 20 operations at cost %v", tt.growth, stats, tt.cost)
+		}
+		if stats.Shrinks != 0 {
+			t.Fatalf("%s: Stats().Shrinks without Shrink = %d, want 0", tt.growth, stats.Shrinks)
+		}
+
+		values, _ := s.ToSlice()
+		for i, value := range values {
+			if value != strconv.Itoa(i) {
+				t.Fatalf("%s: ToSlice()[%d] = %q after growing, want %q", tt.growth, i, value, strconv.Itoa(i))
+			}
+		}
+	}
+}
+
+func TestShrinkStopsAtInitialCapacity(t *testing.T) {
+	s := NewStackService()
+	s.Initialize(4, Policy{Mode: ModeGrowable, Shrink: true})
+	pushN(t, s, 20)
+
+	// Draining 32 slots halves at sizes 7, 3 and 1, and the last halving
+	// lands on the initial capacity.
+	for i := 19; i >= 0; i-- {
+		if value, err := s.Pop(); err != nil || value != strconv.Itoa(i) {
+			t.Fatalf("Pop() = %q, %v, want %q", value, err, strconv.Itoa(i))
+		}
+		if stats, _ := s.Stats(); stats.Capacity < 4 {
+			t.Fatalf("Capacity() = %d at size %d, want at least the initial 4", stats.Capacity, stats.Size)
+		}
+	}
+
+	stats, _ := s.Stats()
+	if stats.Capacity != 4 || stats.Grows != 3 || stats.Shrinks != 3 {
+		t.Fatalf("Stats() = %+v, want capacity 4, 3 grows and 3 shrinks", stats)
+	}
+	if stats.Copies != 28+7+3+1 || stats.Operations != 40 || stats.AmortizedCost != 1.975 {
+		t.Fatalf("Stats() = %+v, want 39 copies over 40 operations", stats)
+	}
+}
+
+func TestShrinkFloorsAtAnUnevenInitialCapacity(t *testing.T) {
+	s := NewStackService()
+	s.Initialize(10, Policy{Mode: ModeGrowable, Growth: GrowthOneAndAHalf, Shrink: true})
+	pushN(t, s, 11)
+
+	for i := 0; i < 9; i++ {
+		s.Pop()
+	}
+
+	// Halving 15 would give 7, which is below where the stack started.
+	if stats, _ := s.Stats(); stats.Capacity != 10 || stats.Shrinks != 1 {
+		t.Fatalf("Stats() = %+v, want capacity 10 after one shrink", stats)
+	}
+}
+
+func TestBoundedPolicy(t *testing.T) {
+	s := NewStackService()
+	s.Initialize(2, Policy{})
+	pushN(t, s, 2)
+
+	if err := s.Push("x"); err != ErrFull {
+		t.Fatalf("Push() on full bounded stack = %v, want %v", err, ErrFull)
+	}
+	if stats, _ := s.Stats(); stats.Policy.Mode != ModeBounded || stats.Capacity != 2 || stats.Copies != 0 {
+		t.Fatalf("Stats() = %+v, want a bounded stack that never copied", stats)
+	}
+
+	for _, policy := range []Policy{
+		{Mode: "ring"},
+		{Mode: ModeBounded, Shrink: true},
+		{Mode: ModeBounded, Growth: GrowthDoubling},
+		{Mode: ModeGrowable, Growth: "3x"},
+	} {
+		if err := NewStackService().Initialize(4, policy); err != ErrInvalidPolicy {
+			t.Fatalf("Initialize(%+v) = %v, want %v", policy, err, ErrInvalidPolicy)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package stack
+
+type StackQueueStats struct {
+	Size      int
+	Inbox     int
+	Outbox    int
+	Transfers int
+	// Moves counts the elements carried from the inbox to the outbox.
+	Moves int
+	// StackOperations counts every push and pop made on the two stacks,
+	// moves included.
+	StackOperations int
+	Operations      int
+	// AmortizedCost is StackOperations per enqueue or dequeue. An element is
+	// pushed, moved once and popped: four stack operations over its two queue
+	// operations, so it settles at 2 however expensive a single transfer was
+	// and only sits above that while moved elements wait in the outbox. The
+	// copies the growable stacks make when they double are not counted; they
+	// keep a push under 2 element writes on their own.
+	AmortizedCost float64
+}
+
+// StackQueueService is a FIFO queue built from two StackService instances.
+type StackQueueService interface {
+	Enqueue(value string) error
+	Dequeue() (string, error)
+	Front() (string, error)
+	Size() int
+	IsEmpty() bool
+	Clear()
+	Stats() StackQueueStats
+}
+
+// stackQueue pushes onto the inbox and serves from the outbox. Only when
+// the outbox runs dry is the whole inbox popped across, which reverses it
+// into queue order.
+type stackQueue struct {
+	inbox           StackService
+	outbox          StackService
+	transfers       int
+	moves           int
+	stackOperations int
+	operations      int
+}
+
+func NewStackQueueService() StackQueueService {
+	q := &stackQueue{}
+	q.Clear()
+	return q
+}
+
+func (q *stackQueue) Enqueue(value string) error {
+	if err := q.inbox.Push(value); err != nil {
+		return err
+	}
+
+	q.stackOperations++
+	q.operations++
+
+	return nil
+}
+
+func (q *stackQueue) Dequeue() (string, error) {
+	if err := q.refill(); err != nil {
+		return "", err
+	}
+
+	value, err := q.outbox.Pop()
+	if err != nil {
+		return "", err
+	}
+
+	q.stackOperations++
+	q.operations++
+
+	return value, nil
+}
+
+func (q *stackQueue) Front() (string, error) {
+	if err := q.refill(); err != nil {
+		return "", err
+	}
+
+	return q.outbox.Peek()
+}
+
+func (q *stackQueue) Size() int {
+	inbox, _ := q.inbox.Size()
+	outbox, _ := q.outbox.Size()
+
+	return inbox + outbox
+}
+
+func (q *stackQueue) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+func (q *stackQueue) Clear() {
+	*q = stackQueue{
+		inbox:  NewGrowable(),
+		outbox: NewGrowable(),
+	}
+}
+
+func (q *stackQueue) Stats() StackQueueStats {
+	inbox, _ := q.inbox.Size()
+	outbox, _ := q.outbox.Size()
+
+	stats := StackQueueStats{
+		Size:            inbox + outbox,
+		Inbox:           inbox,
+		Outbox:          outbox,
+		Transfers:       q.transfers,
+		Moves:           q.moves,
+		StackOperations: q.stackOperations,
+		Operations:      q.operations,
+	}
+
+	if q.operations > 0 {
+		stats.AmortizedCost = float64(q.stackOperations) / float64(q.operations)
+	}
+
+	return stats
+}
+
+/* Private Methods */
+
+// refill moves the inbox over when the outbox is empty and reports
+// ErrEmpty when both are.
+func (q *stackQueue) refill() error {
+	if empty, _ := q.outbox.IsEmpty(); !empty {
+		return nil
+	}
+
+	if empty, _ := q.inbox.IsEmpty(); empty {
+		return ErrEmpty
+	}
+
+	q.transfers++
+	for {
+		value, err := q.inbox.Pop()
+		if err != nil {
+			break
+		}
+
+		q.outbox.Push(value)
+		q.moves++
+		q.stackOperations += 2
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package stack
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestStackQueueInterleavedFIFO(t *testing.T) {
+	q := NewStackQueueService()
+
+	dequeue := func(want string) {
+		t.Helper()
+		if value, err := q.Dequeue(); err != nil || value != want {
+			t.Fatalf("Dequeue() = %q, %v, want %q", value, err, want)
+		}
+	}
+
+	q.Enqueue("a")
+	q.Enqueue("b")
+	dequeue("a")
+
+	// c and d land in the inbox while b still waits in the outbox, and must
+	// not overtake it.
+	q.Enqueue("c")
+	q.Enqueue("d")
+	if stats := q.Stats(); stats.Inbox != 2 || stats.Outbox != 1 {
+		t.Fatalf("Stats() = %+v, want 2 in the inbox and 1 in the outbox", stats)
+	}
+	dequeue("b")
+	dequeue("c")
+
+	q.Enqueue("e")
+	if value, err := q.Front(); err != nil || value != "d" {
+		t.Fatalf("Front() = %q, %v, want %q", value, err, "d")
+	}
+	dequeue("d")
+	dequeue("e")
+
+	if _, err := q.Dequeue(); err != ErrEmpty {
+		t.Fatalf("Dequeue() on empty queue = %v, want %v", err, ErrEmpty)
+	}
+
+	// Only the three times the outbox ran dry moved anything: a and b, then
+	// c and d, then e.
+	stats := q.Stats()
+	if stats.Transfers != 3 || stats.Moves != 5 {
+		t.Fatalf("Stats() = %+v, want 3 transfers and 5 moves", stats)
+	}
+	if stats.Operations != 10 || stats.StackOperations != 20 || stats.AmortizedCost != 2 {
+		t.Fatalf("Stats() = %+v, want 20 stack operations over 10 queue operations", stats)
+	}
+}
+
+func TestStackQueueMatchesSliceModel(t *testing.T) {
+	q := NewStackQueueService()
+
+	var model []string
+	r := rand.New(rand.NewSource(1))
+	for step := 0; step < 3000; step++ {
+		switch r.Intn(5) {
+		case 0, 1, 2:
+			value := strconv.Itoa(step)
+			q.Enqueue(value)
+			model = append(model, value)
+		case 3:
+			value, err := q.Dequeue()
+			if len(model) == 0 {
+				if err != ErrEmpty {
+					t.Fatalf("step %d: Dequeue() when empty = %v, want %v", step, err, ErrEmpty)
+				}
+				continue
+			}
+			if err != nil || value != model[0] {
+				t.Fatalf("step %d: Dequeue() = %q, %v, want %q", step, value, err, model[0])
+			}
+			model = model[1:]
+		case 4:
+			if value, err := q.Front(); len(model) > 0 && (err != nil || value != model[0]) {
+				t.Fatalf("step %d: Front() = %q, %v, want %q", step, value, err, model[0])
+			}
+		}
+
+		if q.Size() != len(model) {
+			t.Fatalf("step %d: Size() = %d, want %d", step, q.Size(), len(model))
+		}
+	}
+
+	q.Clear()
+	if !q.IsEmpty() || q.Stats().Operations != 0 {
+		t.Fatalf("Stats() after Clear() = %+v, want a fresh queue", q.Stats())
+	}
+}